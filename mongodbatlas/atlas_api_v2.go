@@ -0,0 +1,30 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// Versions of the Atlas Administration API v2 used by the requests the matlas client doesn't support. Each endpoint
+// must be requested with a version that includes it, so a version is only bumped along with the payloads that use it.
+const (
+	atlasAPIV2Version20230101 = "2023-01-01"
+	atlasAPIV2Version20231115 = "2023-11-15"
+	atlasAPIV2Version20240530 = "2024-05-30"
+	atlasAPIV2Version20240805 = "2024-08-05"
+	atlasAPIV2AcceptHeaderFmt = "application/vnd.atlas.%s+json"
+)
+
+// doAtlasAPIV2Request sends a request to the given version of the Atlas Administration API v2 and decodes the
+// response into out, if it isn't nil.
+func doAtlasAPIV2Request(ctx context.Context, conn *matlas.Client, method, path, version string, body, out interface{}) (*matlas.Response, error) {
+	req, err := conn.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", fmt.Sprintf(atlasAPIV2AcceptHeaderFmt, version))
+
+	return conn.Do(ctx, req, out)
+}
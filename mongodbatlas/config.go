@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/mongodb-forks/digest"
@@ -18,15 +19,23 @@ import (
 
 const ToolName = "terraform-provider-mongodbatlas"
 
-var userAgent = fmt.Sprintf("%s/%s", ToolName, version.ProviderVersion)
+var baseUserAgent = fmt.Sprintf("%s/%s", ToolName, version.ProviderVersion)
 
 // Config contains the configurations needed to use SDKs
 type Config struct {
-	AssumeRole   *AssumeRole
-	PublicKey    string
-	PrivateKey   string
-	BaseURL      string
-	RealmBaseURL string
+	AssumeRole      *AssumeRole
+	PublicKey       string
+	PrivateKey      string
+	BaseURL         string
+	RealmBaseURL    string
+	UserAgentSuffix string
+	DebugLogging    bool
+	DefaultTags     map[string]string
+	// PreventExternalDeletionRecovery fails the refresh of resources deleted outside of Terraform instead of removing
+	// them from the state.
+	PreventExternalDeletionRecovery bool
+	// AWSRegion is the region of the provider, used to read the AWS Secrets Manager secrets that aren't referenced by ARN.
+	AWSRegion string
 }
 
 // MongoDBClient contains the mongodbatlas clients and configurations
@@ -36,6 +45,10 @@ type MongoDBClient struct {
 	Config  *Config
 }
 
+// testTransportWrapper wraps the transport authenticated with digest, so the acceptance tests can intercept the
+// requests sent to Atlas, e.g. to record and replay them. It's only set by the test provider factories.
+var testTransportWrapper func(http.RoundTripper) http.RoundTripper
+
 // NewClient func...
 func (c *Config) NewClient(ctx context.Context) (interface{}, error) {
 	// setup a transport to handle digest
@@ -47,9 +60,14 @@ func (c *Config) NewClient(ctx context.Context) (interface{}, error) {
 		return nil, err
 	}
 
-	client.Transport = logging.NewTransport("MongoDB Atlas", transport)
+	var atlasTransport http.RoundTripper = transport
+	if testTransportWrapper != nil {
+		atlasTransport = testTransportWrapper(transport)
+	}
+
+	client.Transport = newRequestCacheTransport(newConflictRetryTransport(c.newTransport("MongoDB Atlas", atlasTransport)))
 
-	optsAtlas := []matlasClient.ClientOpt{matlasClient.SetUserAgent(userAgent)}
+	optsAtlas := []matlasClient.ClientOpt{matlasClient.SetUserAgent(c.userAgent())}
 	if c.BaseURL != "" {
 		optsAtlas = append(optsAtlas, matlasClient.SetBaseURL(c.BaseURL))
 	}
@@ -74,10 +92,28 @@ func (c *Config) NewClient(ctx context.Context) (interface{}, error) {
 	return clients, nil
 }
 
+// userAgent returns the User-Agent used by the Atlas and Realm clients, followed by the optional user_agent_suffix.
+func (c *Config) userAgent() string {
+	ua := baseUserAgent
+	if suffix := strings.TrimSpace(c.UserAgentSuffix); suffix != "" {
+		ua += " " + suffix
+	}
+	return ua
+}
+
+// newTransport wraps the transport with the redacting HTTP logger when the debug logging is enabled,
+// otherwise with the default SDK logging transport.
+func (c *Config) newTransport(name string, transport http.RoundTripper) http.RoundTripper {
+	if isDebugLoggingEnabled(c.DebugLogging) {
+		return newLoggingTransport(name, transport)
+	}
+	return logging.NewTransport(name, transport)
+}
+
 func (c *Config) newSDKV2Client(client *http.Client) (*atlasSDK.APIClient, error) {
 	opts := []atlasSDK.ClientModifier{
 		atlasSDK.UseHTTPClient(client),
-		atlasSDK.UseUserAgent(userAgent),
+		atlasSDK.UseUserAgent(c.userAgent()),
 		atlasSDK.UseBaseURL(c.BaseURL),
 		atlasSDK.UseDebug(false)}
 
@@ -96,7 +132,7 @@ func (c *MongoDBClient) GetRealmClient(ctx context.Context) (*realm.Client, erro
 		return nil, errors.New("please set `public_key` and `private_key` in order to use the realm client")
 	}
 
-	optsRealm := []realm.ClientOpt{realm.SetUserAgent(userAgent)}
+	optsRealm := []realm.ClientOpt{realm.SetUserAgent(c.Config.userAgent())}
 	if c.Config.BaseURL != "" && c.Config.RealmBaseURL != "" {
 		optsRealm = append(optsRealm, realm.SetBaseURL(c.Config.RealmBaseURL))
 	}
@@ -107,7 +143,7 @@ func (c *MongoDBClient) GetRealmClient(ctx context.Context) (*realm.Client, erro
 	}
 
 	clientRealm := realmAuth.NewClient(realmAuth.BasicTokenSource(token))
-	clientRealm.Transport = logging.NewTransport("MongoDB Realm", clientRealm.Transport)
+	clientRealm.Transport = c.Config.newTransport("MongoDB Realm", clientRealm.Transport)
 
 	// Initialize the MongoDB Realm API Client.
 	realmClient, err := realm.New(clientRealm, optsRealm...)
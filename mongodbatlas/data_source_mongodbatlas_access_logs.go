@@ -0,0 +1,219 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mwielbut/pointy"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	accessLogsMaxNLogs       = 20000
+	errorAccessLogsRead      = "error getting access logs for project (%s): %s"
+	errorAccessLogsSetting   = "error setting `%s` for access logs (%s): %s"
+	errorAccessLogsDateRange = "invalid `%s` for access logs: %s"
+)
+
+func dataSourceMongoDBAtlasAccessLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasAccessLogsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cluster_name", "hostname"},
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"auth_result": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SUCCESS", "FAILURE"}, false),
+			},
+			"n_logs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, accessLogsMaxNLogs),
+			},
+			"access_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_result": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"auth_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_line": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total_successes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_failures": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasAccessLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+	hostname := d.Get("hostname").(string)
+
+	start, err := accessLogsDateMillis(d, "start")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	end, err := accessLogsDateMillis(d, "end")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &matlas.AccessLogOptions{
+		Start: start,
+		End:   end,
+	}
+	if v, ok := d.GetOk("ip_address"); ok {
+		options.IPAddress = v.(string)
+	}
+	if v, ok := d.GetOk("auth_result"); ok {
+		options.AuthResult = pointy.Bool(v.(string) == "SUCCESS")
+	}
+	if v, ok := d.GetOk("n_logs"); ok {
+		options.NLogs = v.(int)
+	}
+
+	var accessLogs *matlas.AccessLogSettings
+	if clusterName != "" {
+		accessLogs, _, err = conn.AccessTracking.ListByCluster(ctx, projectID, clusterName, options)
+	} else {
+		accessLogs, _, err = conn.AccessTracking.ListByHostname(ctx, projectID, hostname, options)
+	}
+	if err != nil {
+		return diag.Errorf(errorAccessLogsRead, projectID, err)
+	}
+
+	flattened, successes, failures := flattenAccessLogs(accessLogs.AccessLogs)
+	if err := d.Set("access_logs", flattened); err != nil {
+		return diag.Errorf(errorAccessLogsSetting, "access_logs", projectID, err)
+	}
+	if err := d.Set("total_successes", successes); err != nil {
+		return diag.Errorf(errorAccessLogsSetting, "total_successes", projectID, err)
+	}
+	if err := d.Set("total_failures", failures); err != nil {
+		return diag.Errorf(errorAccessLogsSetting, "total_failures", projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"hostname":     hostname,
+		"start":        options.Start,
+		"end":          options.End,
+		"ip_address":   options.IPAddress,
+		"auth_result":  d.Get("auth_result").(string),
+		"n_logs":       strconv.Itoa(options.NLogs),
+	}))
+
+	return nil
+}
+
+// accessLogsDateMillis returns the date of attr in milliseconds since the epoch, as expected by the API.
+func accessLogsDateMillis(d *schema.ResourceData, attr string) (string, error) {
+	value := d.Get(attr).(string)
+	if value == "" {
+		return "", nil
+	}
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", fmt.Errorf(errorAccessLogsDateRange, attr, err)
+	}
+	return strconv.FormatInt(date.UnixMilli(), 10), nil
+}
+
+// flattenAccessLogs returns the access log entries together with the number of successful and failed
+// authentication attempts, so reports and alerts don't need to count them with expressions.
+func flattenAccessLogs(accessLogs []*matlas.AccessLogs) (results []map[string]interface{}, successes, failures int) {
+	results = make([]map[string]interface{}, 0, len(accessLogs))
+	for _, entry := range accessLogs {
+		authResult := entry.AuthResult != nil && *entry.AuthResult
+		if authResult {
+			successes++
+		} else {
+			failures++
+		}
+
+		results = append(results, map[string]interface{}{
+			"auth_result":    authResult,
+			"auth_source":    entry.AuthSource,
+			"failure_reason": entry.FailureReason,
+			"hostname":       entry.Hostname,
+			"ip_address":     entry.IPAddress,
+			"log_line":       entry.LogLine,
+			"timestamp":      entry.Timestamp,
+			"username":       entry.Username,
+		})
+	}
+	return results, successes, failures
+}
@@ -0,0 +1,81 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterDSAccessLogs_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.mongodbatlas_access_logs.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc")
+		clusterName    = acctest.RandomWithPrefix("test-acc-cluster")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMongoDBAtlasAccessLogsConfig(orgID, projectName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_name", clusterName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_logs.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_successes"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_failures"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterDSAccessLogs_invalidTarget(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "mongodbatlas_access_logs" "test" {
+  project_id   = "64b7e2f1c0a7d52f9a1b2c3d"
+  cluster_name = "cluster"
+  hostname     = "cluster-shard-00-00.mongodb.net"
+}
+				`,
+				ExpectError: regexp.MustCompile(`only one of .cluster_name,hostname. can be specified`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMongoDBAtlasAccessLogsConfig(orgID, projectName, clusterName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  name   = %[2]q
+  org_id = %[1]q
+}
+
+resource "mongodbatlas_cluster" "test" {
+  project_id                  = mongodbatlas_project.test.id
+  name                        = %[3]q
+  provider_name               = "AWS"
+  provider_region_name        = "US_EAST_1"
+  provider_instance_size_name = "M10"
+}
+
+data "mongodbatlas_access_logs" "test" {
+  project_id   = mongodbatlas_cluster.test.project_id
+  cluster_name = mongodbatlas_cluster.test.name
+  start        = "2023-01-01T00:00:00Z"
+  auth_result  = "FAILURE"
+  n_logs       = 100
+}
+	`, orgID, projectName, clusterName)
+}
@@ -17,6 +17,10 @@ func dataSourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"include_cluster_settings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"advanced_configuration": clusterAdvancedConfigurationSchemaComputed(),
 			"backup_enabled": {
 				Type:     schema.TypeBool,
@@ -44,6 +48,14 @@ func dataSourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"config_server_management_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_server_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"connection_strings": clusterConnectionStringsSchema(),
 			"create_date": {
 				Type:     schema.TypeString,
@@ -207,6 +219,10 @@ func dataSourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 				},
 				Set: replicationSpecsHashSet,
 			},
+			"replica_set_scaling_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"root_cert_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -320,6 +336,25 @@ func dataSourceMongoDBAtlasAdvancedClusterRead(ctx context.Context, d *schema.Re
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "replication_specs", clusterName, err))
 	}
 
+	if d.Get("include_cluster_settings").(bool) {
+		settings, err := getAdvancedClusterSettings(ctx, conn, projectID, clusterName)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedRead, clusterName, err))
+		}
+
+		if err := d.Set("replica_set_scaling_strategy", settings.ReplicaSetScalingStrategy); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "replica_set_scaling_strategy", clusterName, err))
+		}
+
+		if err := d.Set("config_server_management_mode", settings.ConfigServerManagementMode); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "config_server_management_mode", clusterName, err))
+		}
+
+		if err := d.Set("config_server_type", settings.ConfigServerType); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "config_server_type", clusterName, err))
+		}
+	}
+
 	if err := d.Set("replication_specs", replicationSpecs); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "replication_specs", clusterName, err))
 	}
@@ -20,6 +20,10 @@ func dataSourceMongoDBAtlasAdvancedClusters() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"include_cluster_settings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"results": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -52,6 +56,14 @@ func dataSourceMongoDBAtlasAdvancedClusters() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"config_server_management_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config_server_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"connection_strings": clusterConnectionStringsSchema(),
 						"create_date": {
 							Type:     schema.TypeString,
@@ -215,6 +227,10 @@ func dataSourceMongoDBAtlasAdvancedClusters() *schema.Resource {
 							},
 							Set: replicationSpecsHashSet,
 						},
+						"replica_set_scaling_strategy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"root_cert_type": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -253,14 +269,23 @@ func dataSourceMongoDBAtlasAdvancedClustersRead(ctx context.Context, d *schema.R
 		return diag.FromErr(fmt.Errorf("error reading advanced cluster list for project(%s): %s", projectID, err))
 	}
 
-	if err := d.Set("results", flattenAdvancedClusters(ctx, conn, clusters.Results, d)); err != nil {
+	settings := map[string]*advancedClusterSettings{}
+	if d.Get("include_cluster_settings").(bool) {
+		settings, err = listAdvancedClusterSettings(ctx, conn, projectID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading advanced cluster list for project(%s): %s", projectID, err))
+		}
+	}
+
+	if err := d.Set("results", flattenAdvancedClusters(ctx, conn, clusters.Results, settings, d)); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "results", d.Id(), err))
 	}
 
 	return nil
 }
 
-func flattenAdvancedClusters(ctx context.Context, conn *matlas.Client, clusters []*matlas.AdvancedCluster, d *schema.ResourceData) []map[string]interface{} {
+func flattenAdvancedClusters(ctx context.Context, conn *matlas.Client, clusters []*matlas.AdvancedCluster,
+	settings map[string]*advancedClusterSettings, d *schema.ResourceData) []map[string]interface{} {
 	results := make([]map[string]interface{}, 0)
 
 	for i := range clusters {
@@ -272,12 +297,18 @@ func flattenAdvancedClusters(ctx context.Context, conn *matlas.Client, clusters
 		if err != nil {
 			log.Printf("[WARN] Error setting `replication_specs` for the cluster(%s): %s", clusters[i].ID, err)
 		}
+		clusterSettings, ok := settings[clusters[i].Name]
+		if !ok {
+			clusterSettings = &advancedClusterSettings{}
+		}
 
 		result := map[string]interface{}{
 			"advanced_configuration":         flattenProcessArgs(processArgs),
 			"backup_enabled":                 clusters[i].BackupEnabled,
 			"bi_connector_config":            flattenBiConnectorConfig(clusters[i].BiConnector),
 			"cluster_type":                   clusters[i].ClusterType,
+			"config_server_management_mode":  clusterSettings.ConfigServerManagementMode,
+			"config_server_type":             clusterSettings.ConfigServerType,
 			"create_date":                    clusters[i].CreateDate,
 			"connection_strings":             flattenConnectionStrings(clusters[i].ConnectionStrings),
 			"disk_size_gb":                   clusters[i].DiskSizeGB,
@@ -290,6 +321,7 @@ func flattenAdvancedClusters(ctx context.Context, conn *matlas.Client, clusters
 			"paused":                         clusters[i].Paused,
 			"pit_enabled":                    clusters[i].PitEnabled,
 			"replication_specs":              replicationSpecs,
+			"replica_set_scaling_strategy":   clusterSettings.ReplicaSetScalingStrategy,
 			"root_cert_type":                 clusters[i].RootCertType,
 			"state_name":                     clusters[i].StateName,
 			"termination_protection_enabled": clusters[i].TerminationProtectionEnabled,
@@ -0,0 +1,201 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	mongoDBVersionsPath              = "api/atlas/v2/groups/%s/mongoDBVersions"
+	mongoDBVersionDefaultStatus      = "DEFAULT"
+	errorAtlasLatestVersionsRead     = "error getting available MongoDB versions for project (%s): %s"
+	errorAtlasLatestVersionsSetting  = "error setting `%s` for available MongoDB versions (%s): %s"
+	atlasLatestVersionsItemsPerPage  = 500
+	atlasLatestVersionsMaxPageNumber = 100
+)
+
+type mongoDBVersion struct {
+	CloudProvider string `json:"cloudProvider,omitempty"`
+	DefaultStatus string `json:"defaultStatus,omitempty"`
+	InstanceSize  string `json:"instanceSize,omitempty"`
+	Name          string `json:"name,omitempty"`
+}
+
+type mongoDBVersions struct {
+	Results    []mongoDBVersion `json:"results,omitempty"`
+	TotalCount int              `json:"totalCount,omitempty"`
+}
+
+func dataSourceMongoDBAtlasLatestVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasLatestVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cloud_provider": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AWS", "AZURE", "GCP"}, false),
+			},
+			"instance_size": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasLatestVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	cloudProvider := d.Get("cloud_provider").(string)
+	instanceSize := d.Get("instance_size").(string)
+
+	var versions []mongoDBVersion
+	for page := 1; page <= atlasLatestVersionsMaxPageNumber; page++ {
+		root, err := listMongoDBVersions(ctx, conn, projectID, cloudProvider, instanceSize, page)
+		if err != nil {
+			return diag.Errorf(errorAtlasLatestVersionsRead, projectID, err)
+		}
+
+		versions = append(versions, root.Results...)
+		if len(root.Results) == 0 || len(versions) >= root.TotalCount {
+			break
+		}
+	}
+
+	if err := d.Set("results", flattenMongoDBVersions(versions)); err != nil {
+		return diag.Errorf(errorAtlasLatestVersionsSetting, "results", projectID, err)
+	}
+
+	uniqueVersions, defaultVersion := summarizeMongoDBVersions(versions)
+	if err := d.Set("versions", uniqueVersions); err != nil {
+		return diag.Errorf(errorAtlasLatestVersionsSetting, "versions", projectID, err)
+	}
+	if err := d.Set("default_version", defaultVersion); err != nil {
+		return diag.Errorf(errorAtlasLatestVersionsSetting, "default_version", projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":     projectID,
+		"cloud_provider": cloudProvider,
+		"instance_size":  instanceSize,
+	}))
+
+	return nil
+}
+
+func listMongoDBVersions(ctx context.Context, conn *matlas.Client, projectID, cloudProvider, instanceSize string, pageNum int) (*mongoDBVersions, error) {
+	query := url.Values{}
+	query.Set("pageNum", strconv.Itoa(pageNum))
+	query.Set("itemsPerPage", strconv.Itoa(atlasLatestVersionsItemsPerPage))
+	if cloudProvider != "" {
+		query.Set("cloudProvider", cloudProvider)
+	}
+	if instanceSize != "" {
+		query.Set("instanceSize", instanceSize)
+	}
+
+	path := fmt.Sprintf("%s?%s", fmt.Sprintf(mongoDBVersionsPath, projectID), query.Encode())
+	root := new(mongoDBVersions)
+	if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodGet, path, atlasAPIV2Version20230101, nil, root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+func flattenMongoDBVersions(versions []mongoDBVersion) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		results = append(results, map[string]interface{}{
+			"version":        v.Name,
+			"cloud_provider": v.CloudProvider,
+			"instance_size":  v.InstanceSize,
+			"default":        v.DefaultStatus == mongoDBVersionDefaultStatus,
+		})
+	}
+	return results
+}
+
+// summarizeMongoDBVersions returns the distinct versions sorted from the oldest to the newest and the version
+// Atlas uses by default for new clusters.
+func summarizeMongoDBVersions(versions []mongoDBVersion) (uniqueVersions []string, defaultVersion string) {
+	seen := map[string]bool{}
+	for _, v := range versions {
+		if !seen[v.Name] {
+			seen[v.Name] = true
+			uniqueVersions = append(uniqueVersions, v.Name)
+		}
+		if v.DefaultStatus == mongoDBVersionDefaultStatus {
+			defaultVersion = v.Name
+		}
+	}
+
+	sort.Slice(uniqueVersions, func(i, j int) bool {
+		return mongoDBVersionLess(uniqueVersions[i], uniqueVersions[j])
+	})
+
+	return uniqueVersions, defaultVersion
+}
+
+func mongoDBVersionLess(a, b string) bool {
+	aMajor, aMinor := splitMongoDBVersion(a)
+	bMajor, bMinor := splitMongoDBVersion(b)
+	if aMajor != bMajor {
+		return aMajor < bMajor
+	}
+	return aMinor < bMinor
+}
+
+func splitMongoDBVersion(version string) (major, minor int) {
+	_, _ = fmt.Sscanf(version, "%d.%d", &major, &minor)
+	return major, minor
+}
@@ -0,0 +1,58 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigDSAtlasLatestVersions_basic(t *testing.T) {
+	var (
+		dataSourceName         = "data.mongodbatlas_atlas_latest_versions.test"
+		filteredDataSourceName = "data.mongodbatlas_atlas_latest_versions.filtered"
+		orgID                  = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName            = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMongoDBAtlasLatestVersionsConfig(orgID, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "default_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.version"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "cloud_provider", "AWS"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "results.0.cloud_provider", "AWS"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "results.0.instance_size", "M10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMongoDBAtlasLatestVersionsConfig(orgID, projectName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  name   = %[2]q
+  org_id = %[1]q
+}
+
+data "mongodbatlas_atlas_latest_versions" "test" {
+  project_id = mongodbatlas_project.test.id
+}
+
+data "mongodbatlas_atlas_latest_versions" "filtered" {
+  project_id     = mongodbatlas_project.test.id
+  cloud_provider = "AWS"
+  instance_size  = "M10"
+}
+	`, orgID, projectName)
+}
@@ -3,13 +3,40 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+const (
+	cloudBackupSnapshotsPath = "api/atlas/v1.0/groups/%s/clusters/%s/backup/snapshots"
+	// on-demand snapshots aren't taken by a policy item, so the ondemand frequency_type filter matches their snapshotType
+	cloudBackupSnapshotFrequencyOnDemand = "ondemand"
+	cloudBackupSnapshotTypeOnDemand      = "onDemand"
+)
+
+// cloudBackupSnapshot adds the scheduling fields returned by the API that the atlas client doesn't model yet.
+type cloudBackupSnapshot struct {
+	*matlas.CloudProviderSnapshot
+	FrequencyType string   `json:"frequencyType,omitempty"`
+	PolicyItems   []string `json:"policyItems,omitempty"`
+}
+
+type cloudBackupSnapshots struct {
+	Results    []*cloudBackupSnapshot `json:"results,omitempty"`
+	TotalCount int                    `json:"totalCount,omitempty"`
+}
+
 func dataSourceMongoDBAtlasCloudBackupSnapshots() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceMongoDBAtlasCloudBackupSnapshotsRead,
@@ -30,6 +57,21 @@ func dataSourceMongoDBAtlasCloudBackupSnapshots() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"queued", "inProgress", "completed", "failed"}, false),
+			},
+			"frequency_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"hourly", "daily", "weekly", "monthly", "yearly", "ondemand"}, false),
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
 			"results": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -110,6 +152,21 @@ func dataSourceMongoDBAtlasCloudBackupSnapshots() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"frequency_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_items": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"retention_in_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -125,25 +182,50 @@ func dataSourceMongoDBAtlasCloudBackupSnapshotsRead(ctx context.Context, d *sche
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
 
-	requestParameters := &matlas.SnapshotReqPathParameters{
-		GroupID:     d.Get("project_id").(string),
-		ClusterName: d.Get("cluster_name").(string),
-	}
-	options := &matlas.ListOptions{
-		PageNum:      d.Get("page_num").(int),
-		ItemsPerPage: d.Get("items_per_page").(int),
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+	pageNum := d.Get("page_num").(int)
+	itemsPerPage := d.Get("items_per_page").(int)
+
+	status := d.Get("status").(string)
+	frequencyType := d.Get("frequency_type").(string)
+	var createdAfter time.Time
+	if v := d.Get("created_after").(string); v != "" {
+		createdAfter, _ = time.Parse(time.RFC3339, v)
 	}
+	filtered := status != "" || frequencyType != "" || !createdAfter.IsZero()
 
-	cloudProviderSnapshots, _, err := conn.CloudProviderSnapshots.GetAllCloudProviderSnapshots(ctx, requestParameters, options)
+	snapshots, err := listCloudBackupSnapshots(ctx, conn, projectID, clusterName, pageNum, itemsPerPage)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error getting cloudProviderSnapshots information: %s", err))
 	}
 
-	if err := d.Set("results", flattenCloudBackupSnapshots(cloudProviderSnapshots.Results)); err != nil {
+	// When filtering without an explicit page, go through every page so the filters apply to the whole result set.
+	if filtered && pageNum == 0 {
+		for page := 2; len(snapshots.Results) < snapshots.TotalCount; page++ {
+			nextPage, err := listCloudBackupSnapshots(ctx, conn, projectID, clusterName, page, itemsPerPage)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error getting cloudProviderSnapshots information: %s", err))
+			}
+			if len(nextPage.Results) == 0 {
+				break
+			}
+			snapshots.Results = append(snapshots.Results, nextPage.Results...)
+		}
+	}
+
+	results := snapshots.Results
+	totalCount := snapshots.TotalCount
+	if filtered {
+		results = filterCloudBackupSnapshots(results, status, frequencyType, createdAfter)
+		totalCount = len(results)
+	}
+
+	if err := d.Set("results", flattenCloudBackupSnapshots(results)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `results`: %s", err))
 	}
 
-	if err := d.Set("total_count", cloudProviderSnapshots.TotalCount); err != nil {
+	if err := d.Set("total_count", totalCount); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `total_count`: %s", err))
 	}
 
@@ -152,7 +234,81 @@ func dataSourceMongoDBAtlasCloudBackupSnapshotsRead(ctx context.Context, d *sche
 	return nil
 }
 
-func flattenCloudBackupSnapshots(cloudProviderSnapshots []*matlas.CloudProviderSnapshot) []map[string]interface{} {
+func listCloudBackupSnapshots(ctx context.Context, conn *matlas.Client, projectID, clusterName string, pageNum, itemsPerPage int) (*cloudBackupSnapshots, error) {
+	query := url.Values{}
+	if pageNum > 0 {
+		query.Set("pageNum", strconv.Itoa(pageNum))
+	}
+	if itemsPerPage > 0 {
+		query.Set("itemsPerPage", strconv.Itoa(itemsPerPage))
+	}
+
+	urlStr := fmt.Sprintf(cloudBackupSnapshotsPath, projectID, clusterName)
+	if len(query) > 0 {
+		urlStr = fmt.Sprintf("%s?%s", urlStr, query.Encode())
+	}
+
+	req, err := conn.NewRequest(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(cloudBackupSnapshots)
+	if _, err := conn.Do(ctx, req, root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+func filterCloudBackupSnapshots(snapshots []*cloudBackupSnapshot, status, frequencyType string, createdAfter time.Time) []*cloudBackupSnapshot {
+	var results []*cloudBackupSnapshot
+	for _, snapshot := range snapshots {
+		if snapshot.CloudProviderSnapshot == nil {
+			continue
+		}
+		if status != "" && snapshot.Status != status {
+			continue
+		}
+		if frequencyType == cloudBackupSnapshotFrequencyOnDemand {
+			if snapshot.SnapshotType != cloudBackupSnapshotTypeOnDemand {
+				continue
+			}
+		} else if frequencyType != "" && !strings.EqualFold(snapshot.FrequencyType, frequencyType) {
+			continue
+		}
+		if !createdAfter.IsZero() {
+			createdAt, err := time.Parse(time.RFC3339, snapshot.CreatedAt)
+			if err != nil || createdAt.Before(createdAfter) {
+				continue
+			}
+		}
+		results = append(results, snapshot)
+	}
+
+	// the most recent snapshot comes first, so it can be picked with results.0
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].CreatedAt > results[j].CreatedAt
+	})
+
+	return results
+}
+
+// snapshotRetentionInDays returns the number of days between the creation and the expiration of a snapshot.
+func snapshotRetentionInDays(createdAt, expiresAt string) int {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return 0
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return 0
+	}
+
+	return int(math.Round(expires.Sub(created).Hours() / 24))
+}
+
+func flattenCloudBackupSnapshots(cloudProviderSnapshots []*cloudBackupSnapshot) []map[string]interface{} {
 	var results []map[string]interface{}
 
 	if len(cloudProviderSnapshots) > 0 {
@@ -174,6 +330,9 @@ func flattenCloudBackupSnapshots(cloudProviderSnapshots []*matlas.CloudProviderS
 				"members":            flattenCloudMembers(cloudProviderSnapshot.Members),
 				"replica_set_name":   cloudProviderSnapshot.ReplicaSetName,
 				"snapshot_ids":       cloudProviderSnapshot.SnapshotsIds,
+				"frequency_type":     cloudProviderSnapshot.FrequencyType,
+				"policy_items":       cloudProviderSnapshot.PolicyItems,
+				"retention_in_days":  snapshotRetentionInDays(cloudProviderSnapshot.CreatedAt, cloudProviderSnapshot.ExpiresAt),
 			}
 		}
 	}
@@ -0,0 +1,194 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	errorCloudProviderRegionsRead      = "error getting cloud provider regions for project (%s): %s"
+	errorCloudProviderRegionsSetting   = "error setting `%s` for cloud provider regions (%s): %s"
+	errorCloudProviderRegionsUnmatched = "no region supports the instance size %s for the providers %v in project (%s)"
+)
+
+func dataSourceMongoDBAtlasCloudProviderRegions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasCloudProviderRegionsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"providers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"AWS", "AZURE", "GCP"}, false),
+				},
+			},
+			"tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fail_if_empty": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_sizes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"available_regions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"default": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"availability": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasCloudProviderRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	connV2 := meta.(*MongoDBClient).AtlasV2
+	projectID := d.Get("project_id").(string)
+	providers := cast.ToStringSlice(d.Get("providers"))
+	tier := d.Get("tier").(string)
+
+	request := connV2.ClustersApi.ListCloudProviderRegions(ctx, projectID)
+	if len(providers) > 0 {
+		request = request.Providers(providers)
+	}
+	if tier != "" {
+		request = request.Tier(tier)
+	}
+	regions, _, err := request.Execute()
+	if err != nil {
+		return diag.Errorf(errorCloudProviderRegionsRead, projectID, err)
+	}
+
+	availability := flattenCloudProviderRegionsAvailability(regions.Results)
+	if d.Get("fail_if_empty").(bool) && len(availability) == 0 {
+		return diag.Errorf(errorCloudProviderRegionsUnmatched, tier, providers, projectID)
+	}
+
+	if err := d.Set("results", flattenCloudProviderRegions(regions.Results)); err != nil {
+		return diag.Errorf(errorCloudProviderRegionsSetting, "results", projectID, err)
+	}
+	if err := d.Set("availability", availability); err != nil {
+		return diag.Errorf(errorCloudProviderRegionsSetting, "availability", projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"providers":  fmt.Sprint(providers),
+		"tier":       tier,
+	}))
+
+	return nil
+}
+
+func flattenCloudProviderRegions(results []admin.CloudProviderRegions) []map[string]interface{} {
+	providers := make([]map[string]interface{}, 0, len(results))
+	for _, provider := range results {
+		instanceSizes := make([]map[string]interface{}, 0, len(provider.InstanceSizes))
+		for _, instanceSize := range provider.InstanceSizes {
+			regions := make([]map[string]interface{}, 0, len(instanceSize.AvailableRegions))
+			for _, region := range instanceSize.AvailableRegions {
+				regions = append(regions, map[string]interface{}{
+					"name":    region.GetName(),
+					"default": region.GetDefault(),
+				})
+			}
+			instanceSizes = append(instanceSizes, map[string]interface{}{
+				"name":              instanceSize.GetName(),
+				"available_regions": regions,
+			})
+		}
+		providers = append(providers, map[string]interface{}{
+			"provider":       provider.GetProvider(),
+			"instance_sizes": instanceSizes,
+		})
+	}
+	return providers
+}
+
+// flattenCloudProviderRegionsAvailability returns one entry per provider, instance size and region so the
+// combinations can be used directly in for_each expressions.
+func flattenCloudProviderRegionsAvailability(results []admin.CloudProviderRegions) []map[string]interface{} {
+	availability := make([]map[string]interface{}, 0)
+	for _, provider := range results {
+		for _, instanceSize := range provider.InstanceSizes {
+			for _, region := range instanceSize.AvailableRegions {
+				availability = append(availability, map[string]interface{}{
+					"provider":      provider.GetProvider(),
+					"instance_size": instanceSize.GetName(),
+					"region":        region.GetName(),
+					"default":       region.GetDefault(),
+				})
+			}
+		}
+	}
+	return availability
+}
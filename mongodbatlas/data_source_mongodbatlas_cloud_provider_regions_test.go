@@ -0,0 +1,60 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigDSCloudProviderRegions_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.mongodbatlas_cloud_provider_regions.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMongoDBAtlasCloudProviderRegionsConfig(orgID, projectName, "AWS", "M10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.provider", "AWS"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.instance_sizes.0.name", "M10"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.instance_sizes.0.available_regions.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability.0.provider", "AWS"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability.0.instance_size", "M10"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "availability.0.region"),
+				),
+			},
+			{
+				// GCP has no NVMe storage tiers
+				Config:      testAccDataSourceMongoDBAtlasCloudProviderRegionsConfig(orgID, projectName, "GCP", "M40_NVME"),
+				ExpectError: regexp.MustCompile("no region supports the instance size M40_NVME"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMongoDBAtlasCloudProviderRegionsConfig(orgID, projectName, provider, tier string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  name   = %[2]q
+  org_id = %[1]q
+}
+
+data "mongodbatlas_cloud_provider_regions" "test" {
+  project_id    = mongodbatlas_project.test.id
+  providers     = [%[3]q]
+  tier          = %[4]q
+  fail_if_empty = true
+}
+	`, orgID, projectName, provider, tier)
+}
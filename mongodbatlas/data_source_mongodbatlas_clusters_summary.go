@@ -0,0 +1,200 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	clustersSummaryItemsPerPage  = 500
+	clustersSummaryMaxPageNumber = 100
+	errorClustersSummaryRead     = "error getting clusters summary for project (%s): %s"
+	errorClustersSummarySetting  = "error setting `%s` for clusters summary (%s): %s"
+)
+
+// dataSourceMongoDBAtlasClustersSummary describes the clusters of a project with a handful of attributes read from
+// a single paginated list request, unlike mongodbatlas_advanced_clusters which also reads the advanced configuration
+// of every cluster and flattens the whole replication specs.
+func dataSourceMongoDBAtlasClustersSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasClustersSummaryRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"IDLE", "CREATING", "UPDATING", "DELETING", "REPAIRING"}, false),
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mongo_db_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"paused": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"instance_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"disk_size_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasClustersSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	stateNames := cast.ToStringSlice(d.Get("state_names"))
+
+	var clusters []*matlas.AdvancedCluster
+	for page := 1; page <= clustersSummaryMaxPageNumber; page++ {
+		root, resp, err := conn.AdvancedClusters.List(ctx, projectID, &matlas.ListOptions{
+			PageNum:      page,
+			ItemsPerPage: clustersSummaryItemsPerPage,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				break
+			}
+			return diag.Errorf(errorClustersSummaryRead, projectID, err)
+		}
+
+		clusters = append(clusters, root.Results...)
+		if len(root.Results) == 0 || len(clusters) >= root.TotalCount {
+			break
+		}
+	}
+
+	results := flattenClustersSummary(clusters, stateNames)
+	if err := d.Set("results", results); err != nil {
+		return diag.Errorf(errorClustersSummarySetting, "results", projectID, err)
+	}
+	if err := d.Set("total_count", len(results)); err != nil {
+		return diag.Errorf(errorClustersSummarySetting, "total_count", projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":  projectID,
+		"state_names": fmt.Sprint(stateNames),
+	}))
+
+	return nil
+}
+
+func flattenClustersSummary(clusters []*matlas.AdvancedCluster, stateNames []string) []map[string]interface{} {
+	states := map[string]bool{}
+	for _, state := range stateNames {
+		states[state] = true
+	}
+
+	results := make([]map[string]interface{}, 0, len(clusters))
+	for _, cluster := range clusters {
+		if len(states) > 0 && !states[cluster.StateName] {
+			continue
+		}
+
+		instanceSize, providerName, regionNames := summarizeAdvancedReplicationSpecs(cluster.ReplicationSpecs)
+		results = append(results, map[string]interface{}{
+			"cluster_id":       cluster.ID,
+			"name":             cluster.Name,
+			"state_name":       cluster.StateName,
+			"cluster_type":     cluster.ClusterType,
+			"mongo_db_version": cluster.MongoDBVersion,
+			"paused":           cluster.Paused != nil && *cluster.Paused,
+			"instance_size":    instanceSize,
+			"provider_name":    providerName,
+			"region_names":     regionNames,
+			"disk_size_gb":     cast.ToFloat64(cluster.DiskSizeGB),
+		})
+	}
+	return results
+}
+
+// summarizeAdvancedReplicationSpecs returns the electable instance size and the provider of the highest priority
+// region, and the distinct regions of the cluster.
+func summarizeAdvancedReplicationSpecs(specs []*matlas.AdvancedReplicationSpec) (instanceSize, providerName string, regionNames []string) {
+	seen := map[string]bool{}
+	highestPriority := -1
+	for _, spec := range specs {
+		for _, regionConfig := range spec.RegionConfigs {
+			if regionConfig == nil {
+				continue
+			}
+			if !seen[regionConfig.RegionName] {
+				seen[regionConfig.RegionName] = true
+				regionNames = append(regionNames, regionConfig.RegionName)
+			}
+
+			priority := cast.ToInt(regionConfig.Priority)
+			if priority <= highestPriority {
+				continue
+			}
+			highestPriority = priority
+			providerName = regionConfig.ProviderName
+			if providerName == "TENANT" {
+				providerName = regionConfig.BackingProviderName
+			}
+			if regionConfig.ElectableSpecs != nil {
+				instanceSize = regionConfig.ElectableSpecs.InstanceSize
+			}
+		}
+	}
+	return instanceSize, providerName, regionNames
+}
@@ -0,0 +1,72 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterDSClustersSummary_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.mongodbatlas_clusters_summary.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc")
+		clusterName    = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMongoDBAtlasClustersSummaryConfig(orgID, projectName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "total_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.name", clusterName),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.state_name", "IDLE"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.cluster_type", "REPLICASET"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.instance_size", "M10"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.provider_name", "AWS"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.region_names.0", "US_EAST_1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.cluster_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMongoDBAtlasClustersSummaryConfig(orgID, projectName, clusterName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  name   = %[2]q
+  org_id = %[1]q
+}
+
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id   = mongodbatlas_project.test.id
+  name         = %[3]q
+  cluster_type = "REPLICASET"
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = "M10"
+        node_count    = 3
+      }
+      provider_name = "AWS"
+      priority      = 7
+      region_name   = "US_EAST_1"
+    }
+  }
+}
+
+data "mongodbatlas_clusters_summary" "test" {
+  project_id  = mongodbatlas_advanced_cluster.test.project_id
+  state_names = ["IDLE"]
+}
+	`, orgID, projectName, clusterName)
+}
@@ -0,0 +1,236 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	eventsItemsPerPage      = 500
+	eventsDefaultMaxResults = 100
+	errorEventsRead         = "error getting events for %s: %s"
+	errorEventsSetting      = "error setting `%s` for events (%s): %s"
+	errorEventsMarshalling  = "error writing event (%s): %s"
+)
+
+func dataSourceMongoDBAtlasEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasEventsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"project_id", "org_id"},
+			},
+			"org_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"event_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"min_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      eventsDefaultMaxResults,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"org_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_config_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_global_admin": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"raw": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	orgID := d.Get("org_id").(string)
+	eventTypes := cast.ToStringSlice(d.Get("event_types"))
+	maxResults := d.Get("max_results").(int)
+
+	target := fmt.Sprintf("project (%s)", projectID)
+	if orgID != "" {
+		target = fmt.Sprintf("organization (%s)", orgID)
+	}
+
+	options := &matlas.EventListOptions{
+		ListOptions: matlas.ListOptions{ItemsPerPage: eventsItemsPerPage},
+		EventType:   eventTypes,
+		MinDate:     d.Get("min_date").(string),
+		MaxDate:     d.Get("max_date").(string),
+	}
+
+	var (
+		events     []*matlas.Event
+		totalCount int
+	)
+	for page := 1; len(events) < maxResults; page++ {
+		options.PageNum = page
+		var (
+			root *matlas.EventResponse
+			err  error
+		)
+		if orgID != "" {
+			root, _, err = conn.Events.ListOrganizationEvents(ctx, orgID, options)
+		} else {
+			root, _, err = conn.Events.ListProjectEvents(ctx, projectID, options)
+		}
+		if err != nil {
+			return diag.Errorf(errorEventsRead, target, err)
+		}
+
+		events = append(events, root.Results...)
+		totalCount = root.TotalCount
+		if len(root.Results) < eventsItemsPerPage || len(events) >= totalCount {
+			break
+		}
+	}
+	if len(events) > maxResults {
+		events = events[:maxResults]
+	}
+
+	results, err := flattenEvents(events)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("results", results); err != nil {
+		return diag.Errorf(errorEventsSetting, "results", target, err)
+	}
+	if err := d.Set("total_count", totalCount); err != nil {
+		return diag.Errorf(errorEventsSetting, "total_count", target, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":  projectID,
+		"org_id":      orgID,
+		"event_types": strings.Join(eventTypes, ","),
+		"min_date":    options.MinDate,
+		"max_date":    options.MaxDate,
+	}))
+
+	return nil
+}
+
+// flattenEvents maps the attributes shared by most event types and keeps the whole event as JSON in `raw`, since
+// every event type has its own set of attributes.
+func flattenEvents(events []*matlas.Event) ([]map[string]interface{}, error) {
+	results := make([]map[string]interface{}, 0, len(events))
+	for _, event := range events {
+		raw, err := json.Marshal(event)
+		if err != nil {
+			return nil, fmt.Errorf(errorEventsMarshalling, event.ID, err)
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":              event.ID,
+			"event_type_name": event.EventTypeName,
+			"created":         event.Created,
+			"project_id":      event.GroupID,
+			"org_id":          event.OrgID,
+			"user_id":         event.UserID,
+			"username":        event.Username,
+			"target_username": event.TargetUsername,
+			"remote_address":  event.RemoteAddress,
+			"alert_id":        event.AlertID,
+			"alert_config_id": event.AlertConfigID,
+			"api_key_id":      event.APIKeyID,
+			"public_key":      event.PublicKey,
+			"is_global_admin": event.IsGlobalAdmin,
+			"raw":             string(raw),
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,57 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigDSEvents_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.mongodbatlas_events.project"
+		orgDataSource  = "data.mongodbatlas_events.org"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMongoDBAtlasEventsConfig(orgID, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "project_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.event_type_name", "GROUP_CREATED"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.created"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.raw"),
+					resource.TestCheckResourceAttr(orgDataSource, "org_id", orgID),
+					resource.TestCheckResourceAttr(orgDataSource, "results.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMongoDBAtlasEventsConfig(orgID, projectName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  name   = %[2]q
+  org_id = %[1]q
+}
+
+data "mongodbatlas_events" "project" {
+  project_id  = mongodbatlas_project.test.id
+  event_types = ["GROUP_CREATED"]
+}
+
+data "mongodbatlas_events" "org" {
+  org_id      = mongodbatlas_project.test.org_id
+  max_results = 5
+}
+	`, orgID, projectName)
+}
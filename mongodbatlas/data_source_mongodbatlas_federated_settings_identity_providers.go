@@ -4,14 +4,45 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+// the protocol and idpType filters and the OIDC attributes are only in the 2023-11-15 version of the API, which the
+// admin SDK used by the provider doesn't support yet
+const federatedSettingsIdentityProvidersPath = "api/atlas/v2/federationSettings/%s/identityProviders"
+
+var (
+	federatedSettingsIdentityProviderProtocols = []string{"SAML", "OIDC"}
+	federatedSettingsIdentityProviderIdpTypes  = []string{"WORKFORCE", "WORKLOAD"}
+)
+
+// federatedSettingsIdentityProviderWithProtocol adds the protocol, the type and the OIDC attributes of an identity
+// provider, which aren't part of matlas.FederatedSettingsIdentityProvider.
+type federatedSettingsIdentityProviderWithProtocol struct {
+	matlas.FederatedSettingsIdentityProvider
+	ID                string `json:"id,omitempty"`
+	Protocol          string `json:"protocol,omitempty"`
+	IdpType           string `json:"idpType,omitempty"`
+	Audience          string `json:"audience,omitempty"`
+	AuthorizationType string `json:"authorizationType,omitempty"`
+	ClientID          string `json:"clientId,omitempty"`
+	GroupsClaim       string `json:"groupsClaim,omitempty"`
+	UserClaim         string `json:"userClaim,omitempty"`
+}
+
+type federatedSettingsIdentityProviders struct {
+	Results    []federatedSettingsIdentityProviderWithProtocol `json:"results,omitempty"`
+	TotalCount int                                             `json:"totalCount,omitempty"`
+}
+
 func dataSourceMongoDBAtlasFederatedSettingsIdentityProviders() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceMongoDBAtlasFederatedSettingsIdentityProvidersRead,
@@ -28,11 +59,39 @@ func dataSourceMongoDBAtlasFederatedSettingsIdentityProviders() *schema.Resource
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"protocols": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(federatedSettingsIdentityProviderProtocols, false),
+				},
+			},
+			"idp_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(federatedSettingsIdentityProviderIdpTypes, false),
+				},
+			},
 			"results": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"idp_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"idp_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"acs_url": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -146,6 +205,26 @@ func dataSourceMongoDBAtlasFederatedSettingsIdentityProviders() *schema.Resource
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"audience": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorization_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"groups_claim": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_claim": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"display_name": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -217,22 +296,36 @@ func dataSourceMongoDBAtlasFederatedSettingsIdentityProvidersRead(ctx context.Co
 	conn := meta.(*MongoDBClient).Atlas
 
 	federationSettingsID, federationSettingsIDOk := d.GetOk("federation_settings_id")
+	if !federationSettingsIDOk {
+		return diag.FromErr(errors.New("federation_settings_id must be configured"))
+	}
 
-	options := &matlas.ListOptions{
-		PageNum:      d.Get("page_num").(int),
-		ItemsPerPage: d.Get("items_per_page").(int),
+	query := url.Values{}
+	if pageNum := d.Get("page_num").(int); pageNum > 0 {
+		query.Set("pageNum", fmt.Sprint(pageNum))
+	}
+	if itemsPerPage := d.Get("items_per_page").(int); itemsPerPage > 0 {
+		query.Set("itemsPerPage", fmt.Sprint(itemsPerPage))
+	}
+	// without protocols Atlas only returns the SAML identity providers
+	for _, protocol := range d.Get("protocols").([]interface{}) {
+		query.Add("protocol", protocol.(string))
+	}
+	for _, idpType := range d.Get("idp_types").([]interface{}) {
+		query.Add("idpType", idpType.(string))
 	}
 
-	if !federationSettingsIDOk {
-		return diag.FromErr(errors.New("federation_settings_id must be configured"))
+	path := fmt.Sprintf(federatedSettingsIdentityProvidersPath, federationSettingsID.(string))
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
 	}
 
-	federatedSettingsIdentityProviders, _, err := conn.FederatedSettings.ListIdentityProviders(ctx, federationSettingsID.(string), options)
-	if err != nil {
+	root := new(federatedSettingsIdentityProviders)
+	if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodGet, path, atlasAPIV2Version20231115, nil, root); err != nil {
 		return diag.Errorf("error getting federatedSettings IdentityProviders assigned (%s): %s", federationSettingsID, err)
 	}
 
-	if err := d.Set("results", flattenFederatedSettingsIdentityProvider(federatedSettingsIdentityProviders)); err != nil {
+	if err := d.Set("results", flattenFederatedSettingsIdentityProvider(root.Results)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `result` for federatedSettings IdentityProviders: %s", err))
 	}
 
@@ -241,22 +334,36 @@ func dataSourceMongoDBAtlasFederatedSettingsIdentityProvidersRead(ctx context.Co
 	return nil
 }
 
-func flattenFederatedSettingsIdentityProvider(federatedSettingsIdentityProvider []matlas.FederatedSettingsIdentityProvider) []map[string]interface{} {
+func flattenFederatedSettingsIdentityProvider(federatedSettingsIdentityProvider []federatedSettingsIdentityProviderWithProtocol) []map[string]interface{} {
 	var federatedSettingsIdentityProviderMap []map[string]interface{}
 
 	if len(federatedSettingsIdentityProvider) > 0 {
 		federatedSettingsIdentityProviderMap = make([]map[string]interface{}, len(federatedSettingsIdentityProvider))
 
 		for i := range federatedSettingsIdentityProvider {
+			// OIDC identity providers don't have a certificate
+			var pemFileInfo []map[string]interface{}
+			if federatedSettingsIdentityProvider[i].PemFileInfo != nil {
+				pemFileInfo = flattenPemFileInfo(*federatedSettingsIdentityProvider[i].PemFileInfo)
+			}
+
 			federatedSettingsIdentityProviderMap[i] = map[string]interface{}{
+				"idp_id":                       federatedSettingsIdentityProvider[i].ID,
+				"protocol":                     federatedSettingsIdentityProvider[i].Protocol,
+				"idp_type":                     federatedSettingsIdentityProvider[i].IdpType,
 				"acs_url":                      federatedSettingsIdentityProvider[i].AcsURL,
 				"associated_domains":           federatedSettingsIdentityProvider[i].AssociatedDomains,
 				"associated_orgs":              flattenAssociatedOrgs(federatedSettingsIdentityProvider[i].AssociatedOrgs),
 				"audience_uri":                 federatedSettingsIdentityProvider[i].AudienceURI,
+				"audience":                     federatedSettingsIdentityProvider[i].Audience,
+				"authorization_type":           federatedSettingsIdentityProvider[i].AuthorizationType,
+				"client_id":                    federatedSettingsIdentityProvider[i].ClientID,
+				"groups_claim":                 federatedSettingsIdentityProvider[i].GroupsClaim,
+				"user_claim":                   federatedSettingsIdentityProvider[i].UserClaim,
 				"display_name":                 federatedSettingsIdentityProvider[i].DisplayName,
 				"issuer_uri":                   federatedSettingsIdentityProvider[i].IssuerURI,
 				"okta_idp_id":                  federatedSettingsIdentityProvider[i].OktaIdpID,
-				"pem_file_info":                flattenPemFileInfo(*federatedSettingsIdentityProvider[i].PemFileInfo),
+				"pem_file_info":                pemFileInfo,
 				"request_binding":              federatedSettingsIdentityProvider[i].RequestBinding,
 				"response_signature_algorithm": federatedSettingsIdentityProvider[i].ResponseSignatureAlgorithm,
 				"sso_debug_enabled":            federatedSettingsIdentityProvider[i].SsoDebugEnabled,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -36,6 +37,35 @@ func TestAccFedDSFederatedSettingsIdentityProviders_basic(t *testing.T) {
 	})
 }
 
+func TestAccFedDSFederatedSettingsIdentityProviders_protocols(t *testing.T) {
+	SkipTestExtCred(t)
+	var (
+		resourceName        = "data.mongodbatlas_federated_settings_identity_providers.test"
+		federatedSettingsID = os.Getenv("MONGODB_ATLAS_FEDERATION_SETTINGS_ID")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testCheckFederatedSettings(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasDataSourceFederatedSettingsIdentityProvidersConfigProtocols(federatedSettingsID, "SAML"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "results.#"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.protocol", "SAML"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.idp_type", "WORKFORCE"),
+					resource.TestCheckResourceAttrSet(resourceName, "results.0.idp_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "results.0.okta_idp_id"),
+				),
+			},
+			{
+				Config:      testAccMongoDBAtlasDataSourceFederatedSettingsIdentityProvidersConfigProtocols(federatedSettingsID, "LDAP"),
+				ExpectError: regexp.MustCompile("expected protocols.0 to be one of"),
+			},
+		},
+	})
+}
+
 func testAccMongoDBAtlasDataSourceFederatedSettingsIdentityProvidersConfig(federatedSettingsID string) string {
 	return fmt.Sprintf(`
 		data "mongodbatlas_federated_settings_identity_providers" "test" {
@@ -67,3 +97,13 @@ func testAccCheckMongoDBAtlasFederatedSettingsIdentityProvidersExists(resourceNa
 		return nil
 	}
 }
+
+func testAccMongoDBAtlasDataSourceFederatedSettingsIdentityProvidersConfigProtocols(federatedSettingsID, protocol string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_federated_settings_identity_providers" "test" {
+			federation_settings_id = "%[1]s"
+			protocols              = ["%[2]s"]
+			idp_types              = ["WORKFORCE"]
+		}
+`, federatedSettingsID, protocol)
+}
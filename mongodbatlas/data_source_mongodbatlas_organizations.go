@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mwielbut/pointy"
 
 	matlas "go.mongodb.org/atlas/mongodbatlas"
@@ -25,12 +26,14 @@ func dataSourceMongoDBAtlasOrganizations() *schema.Resource {
 				Optional: true,
 			},
 			"page_num": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"items_per_page": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
 			},
 			"results": {
 				Type:     schema.TypeList,
@@ -96,6 +99,21 @@ func dataSourceMongoDBAtlasOrganizationsRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf("error getting organization information: %s", err))
 	}
 
+	// Without an explicit page, return every organization visible to the API key.
+	if options.PageNum == 0 {
+		for page := 2; len(organizations.Results) < organizations.TotalCount; page++ {
+			organizationOptions.PageNum = page
+			nextPage, _, err := conn.Organizations.List(ctx, organizationOptions)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error getting organization information: %s", err))
+			}
+			if len(nextPage.Results) == 0 {
+				break
+			}
+			organizations.Results = append(organizations.Results, nextPage.Results...)
+		}
+	}
+
 	if err := d.Set("results", flattenOrganizations(organizations.Results)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `results`: %s", err))
 	}
@@ -2,6 +2,7 @@ package mongodbatlas
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -43,6 +44,27 @@ func TestAccConfigDSOrganizations_withPagination(t *testing.T) {
 	})
 }
 
+func TestAccConfigDSOrganizations_withName(t *testing.T) {
+	var (
+		datasourceName = "data.mongodbatlas_organizations.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasOrganizationsConfigWithName(orgID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "results.0.id", orgID),
+					resource.TestCheckResourceAttrSet(datasourceName, "results.0.links.#"),
+				),
+			},
+		},
+	})
+}
+
 func testAccMongoDBAtlasOrganizationsConfigWithDS(includedeletedorgs bool) string {
 	config := fmt.Sprintf(`
 		
@@ -61,3 +83,15 @@ func testAccMongoDBAtlasOrganizationsConfigWithPagination(pageNum, itemPage int)
 		}
 	`, pageNum, itemPage)
 }
+
+func testAccMongoDBAtlasOrganizationsConfigWithName(orgID string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_organization" "test" {
+			org_id = %[1]q
+		}
+
+		data "mongodbatlas_organizations" "test" {
+			name = data.mongodbatlas_organization.test.name
+		}
+	`, orgID)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -20,7 +19,6 @@ func dataSourceMongoDBAtlasPrivateLinkEndpointsServiceServerless() *schema.Resou
 			"instance_name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"page_num": {
 				Type:     schema.TypeInt,
@@ -45,8 +43,7 @@ func dataSourceMongoDBAtlasPrivateLinkEndpointsServiceServerless() *schema.Resou
 						},
 						"endpoint_id": {
 							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Computed: true,
 						},
 						"endpoint_service_name": {
 							Type:     schema.TypeString,
@@ -95,7 +92,10 @@ func dataSourceMongoDBAtlasPrivateLinkEndpointsServiceServerlessRead(ctx context
 		return diag.Errorf("error setting `results`: %s", err)
 	}
 
-	d.SetId(id.UniqueId())
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":    projectID,
+		"instance_name": instanceName,
+	}))
 
 	return nil
 }
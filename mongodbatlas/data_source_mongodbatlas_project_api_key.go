@@ -33,6 +33,14 @@ func dataSourceMongoDBAtlasProjectAPIKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"project_assignment": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -62,7 +70,7 @@ func dataSourceMongoDBAtlasProjectAPIKeyRead(ctx context.Context, d *schema.Reso
 
 	projectID := d.Get("project_id").(string)
 	apiKeyID := d.Get("api_key_id").(string)
-	projectAPIKeys, _, err := conn.ProjectAPIKeys.List(ctx, projectID, nil)
+	projectAPIKeys, err := listProjectAPIKeys(ctx, conn, projectID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error getting api key information: %s", err))
 	}
@@ -84,6 +92,14 @@ func dataSourceMongoDBAtlasProjectAPIKeyRead(ctx context.Context, d *schema.Reso
 			return diag.FromErr(fmt.Errorf("error setting `private_key`: %s", err))
 		}
 
+		if err := d.Set("created_at", val.CreatedAt); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `created_at`: %s", err))
+		}
+
+		if err := d.Set("last_used", val.LastUsed); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `last_used`: %s", err))
+		}
+
 		if projectAssignments, err := newProjectAssignment(ctx, conn, apiKeyID); err == nil {
 			if err := d.Set("project_assignment", projectAssignments); err != nil {
 				return diag.Errorf(errorProjectSetting, `project_assignment`, projectID, err)
@@ -0,0 +1,482 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	projectExportItemsPerPage = 500
+	errorProjectExportRead    = "error exporting %s of project (%s): %s"
+	errorProjectExportSetting = "error setting `%s` for project export (%s): %s"
+
+	projectExportClusterType        = "mongodbatlas_advanced_cluster"
+	projectExportDatabaseUserType   = "mongodbatlas_database_user"
+	projectExportAccessListType     = "mongodbatlas_project_ip_access_list"
+	projectExportNetworkPeeringType = "mongodbatlas_network_peering"
+	projectExportBackupScheduleType = "mongodbatlas_cloud_backup_schedule"
+)
+
+var projectExportResourceTypes = []string{
+	projectExportClusterType,
+	projectExportDatabaseUserType,
+	projectExportAccessListType,
+	projectExportNetworkPeeringType,
+	projectExportBackupScheduleType,
+}
+
+var projectExportInvalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// projectExportResource is a resource found in the exported project, with the import ID expected by the provider
+// and a skeleton of its configuration.
+type projectExportResource struct {
+	resourceType string
+	name         string
+	importID     string
+	attributes   [][2]string
+	blocks       []string
+}
+
+// dataSourceMongoDBAtlasProjectExport walks the resources of an existing project and returns Terraform import
+// blocks and skeleton configuration for them, to bring projects created outside of Terraform under its management.
+func dataSourceMongoDBAtlasProjectExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasProjectExportRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(projectExportResourceTypes, false),
+				},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"import_blocks": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasProjectExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	resourceTypes := cast.ToStringSlice(d.Get("resource_types"))
+	if len(resourceTypes) == 0 {
+		resourceTypes = projectExportResourceTypes
+	}
+
+	clusters, err := listProjectExportClusters(ctx, conn, projectID)
+	if err != nil {
+		return diag.Errorf(errorProjectExportRead, "clusters", projectID, err)
+	}
+
+	var resources []projectExportResource
+	for _, resourceType := range projectExportResourceTypes {
+		if !isElementExist(resourceTypes, resourceType) {
+			continue
+		}
+
+		var found []projectExportResource
+		switch resourceType {
+		case projectExportClusterType:
+			found = exportAdvancedClusters(projectID, clusters)
+		case projectExportDatabaseUserType:
+			found, err = exportDatabaseUsers(ctx, conn, projectID)
+		case projectExportAccessListType:
+			found, err = exportAccessListEntries(ctx, conn, projectID)
+		case projectExportNetworkPeeringType:
+			found, err = exportNetworkPeerings(ctx, conn, projectID)
+		case projectExportBackupScheduleType:
+			found = exportBackupSchedules(projectID, clusters)
+		}
+		if err != nil {
+			return diag.Errorf(errorProjectExportRead, resourceType, projectID, err)
+		}
+		resources = append(resources, found...)
+	}
+	setProjectExportNames(resources)
+
+	if err := d.Set("resources", flattenProjectExportResources(resources)); err != nil {
+		return diag.Errorf(errorProjectExportSetting, "resources", projectID, err)
+	}
+	if err := d.Set("import_blocks", projectExportImportBlocks(resources)); err != nil {
+		return diag.Errorf(errorProjectExportSetting, "import_blocks", projectID, err)
+	}
+	if err := d.Set("config", projectExportConfig(resources)); err != nil {
+		return diag.Errorf(errorProjectExportSetting, "config", projectID, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":     projectID,
+		"resource_types": strings.Join(resourceTypes, ","),
+	}))
+
+	return nil
+}
+
+func listProjectExportClusters(ctx context.Context, conn *matlas.Client, projectID string) ([]*matlas.AdvancedCluster, error) {
+	var clusters []*matlas.AdvancedCluster
+	for page := 1; ; page++ {
+		root, _, err := conn.AdvancedClusters.List(ctx, projectID, &matlas.ListOptions{
+			PageNum:      page,
+			ItemsPerPage: projectExportItemsPerPage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		clusters = append(clusters, root.Results...)
+		if len(root.Results) == 0 || len(clusters) >= root.TotalCount {
+			return clusters, nil
+		}
+	}
+}
+
+func exportAdvancedClusters(projectID string, clusters []*matlas.AdvancedCluster) []projectExportResource {
+	resources := make([]projectExportResource, 0, len(clusters))
+	for _, cluster := range clusters {
+		var blocks []string
+		for _, spec := range cluster.ReplicationSpecs {
+			var regionConfigs []string
+			for _, regionConfig := range spec.RegionConfigs {
+				if regionConfig == nil {
+					continue
+				}
+				attributes := [][2]string{
+					{"provider_name", hclString(regionConfig.ProviderName)},
+					{"region_name", hclString(regionConfig.RegionName)},
+					{"priority", fmt.Sprint(cast.ToInt(regionConfig.Priority))},
+				}
+				if regionConfig.BackingProviderName != "" {
+					attributes = append(attributes, [2]string{"backing_provider_name", hclString(regionConfig.BackingProviderName)})
+				}
+				var specs []string
+				if regionConfig.ElectableSpecs != nil {
+					specs = append(specs, hclBlock("electable_specs", [][2]string{
+						{"instance_size", hclString(regionConfig.ElectableSpecs.InstanceSize)},
+						{"node_count", fmt.Sprint(cast.ToInt(regionConfig.ElectableSpecs.NodeCount))},
+					}, nil))
+				}
+				regionConfigs = append(regionConfigs, hclBlock("region_configs", attributes, specs))
+			}
+			blocks = append(blocks, hclBlock("replication_specs", [][2]string{
+				{"num_shards", fmt.Sprint(spec.NumShards)},
+			}, regionConfigs))
+		}
+
+		resources = append(resources, projectExportResource{
+			resourceType: projectExportClusterType,
+			name:         cluster.Name,
+			importID:     fmt.Sprintf("%s-%s", projectID, cluster.Name),
+			attributes: [][2]string{
+				{"project_id", hclString(projectID)},
+				{"name", hclString(cluster.Name)},
+				{"cluster_type", hclString(cluster.ClusterType)},
+			},
+			blocks: blocks,
+		})
+	}
+	return resources
+}
+
+func exportDatabaseUsers(ctx context.Context, conn *matlas.Client, projectID string) ([]projectExportResource, error) {
+	var resources []projectExportResource
+	for page := 1; ; page++ {
+		users, _, err := conn.DatabaseUsers.List(ctx, projectID, &matlas.ListOptions{
+			PageNum:      page,
+			ItemsPerPage: projectExportItemsPerPage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range users {
+			user := &users[i]
+			var roles []string
+			for _, role := range user.Roles {
+				attributes := [][2]string{
+					{"role_name", hclString(role.RoleName)},
+					{"database_name", hclString(role.DatabaseName)},
+				}
+				if role.CollectionName != "" {
+					attributes = append(attributes, [2]string{"collection_name", hclString(role.CollectionName)})
+				}
+				roles = append(roles, hclBlock("roles", attributes, nil))
+			}
+
+			resources = append(resources, projectExportResource{
+				resourceType: projectExportDatabaseUserType,
+				name:         user.Username,
+				importID:     fmt.Sprintf("%s-%s-%s", projectID, user.Username, user.DatabaseName),
+				attributes: [][2]string{
+					{"project_id", hclString(projectID)},
+					{"username", hclString(user.Username)},
+					{"auth_database_name", hclString(user.DatabaseName)},
+				},
+				blocks: roles,
+			})
+		}
+		if len(users) < projectExportItemsPerPage {
+			return resources, nil
+		}
+	}
+}
+
+func exportAccessListEntries(ctx context.Context, conn *matlas.Client, projectID string) ([]projectExportResource, error) {
+	var resources []projectExportResource
+	for page := 1; ; page++ {
+		entries, _, err := conn.ProjectIPAccessList.List(ctx, projectID, &matlas.ListOptions{
+			PageNum:      page,
+			ItemsPerPage: projectExportItemsPerPage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries.Results {
+			attributes := [][2]string{{"project_id", hclString(projectID)}}
+			var value string
+			switch {
+			case entry.AwsSecurityGroup != "":
+				value = entry.AwsSecurityGroup
+				attributes = append(attributes, [2]string{"aws_security_group", hclString(value)})
+			case entry.IPAddress != "":
+				value = entry.IPAddress
+				attributes = append(attributes, [2]string{"ip_address", hclString(value)})
+			default:
+				value = entry.CIDRBlock
+				attributes = append(attributes, [2]string{"cidr_block", hclString(value)})
+			}
+			if entry.Comment != "" {
+				attributes = append(attributes, [2]string{"comment", hclString(entry.Comment)})
+			}
+
+			resources = append(resources, projectExportResource{
+				resourceType: projectExportAccessListType,
+				name:         value,
+				importID:     fmt.Sprintf("%s-%s", projectID, value),
+				attributes:   attributes,
+			})
+		}
+		if len(entries.Results) < projectExportItemsPerPage {
+			return resources, nil
+		}
+	}
+}
+
+func exportNetworkPeerings(ctx context.Context, conn *matlas.Client, projectID string) ([]projectExportResource, error) {
+	var resources []projectExportResource
+	for _, providerName := range networkPeeringProviders {
+		peers, _, err := conn.Peers.List(ctx, projectID, &matlas.ContainersListOptions{ProviderName: providerName})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range peers {
+			peer := &peers[i]
+			attributes := [][2]string{
+				{"project_id", hclString(projectID)},
+				{"container_id", hclString(peer.ContainerID)},
+				{"provider_name", hclString(providerName)},
+			}
+			var name string
+			switch providerName {
+			case "AWS":
+				name = peer.VpcID
+				attributes = append(attributes,
+					[2]string{"accepter_region_name", hclString(peer.AccepterRegionName)},
+					[2]string{"aws_account_id", hclString(peer.AWSAccountID)},
+					[2]string{"route_table_cidr_block", hclString(peer.RouteTableCIDRBlock)},
+					[2]string{"vpc_id", hclString(peer.VpcID)},
+				)
+			case "AZURE":
+				name = peer.VNetName
+				attributes = append(attributes,
+					[2]string{"azure_directory_id", hclString(peer.AzureDirectoryID)},
+					[2]string{"azure_subscription_id", hclString(peer.AzureSubscriptionID)},
+					[2]string{"resource_group_name", hclString(peer.ResourceGroupName)},
+					[2]string{"vnet_name", hclString(peer.VNetName)},
+				)
+			case "GCP":
+				name = peer.NetworkName
+				attributes = append(attributes,
+					[2]string{"gcp_project_id", hclString(peer.GCPProjectID)},
+					[2]string{"network_name", hclString(peer.NetworkName)},
+				)
+			}
+
+			resources = append(resources, projectExportResource{
+				resourceType: projectExportNetworkPeeringType,
+				name:         name,
+				importID:     fmt.Sprintf("%s-%s-%s", projectID, peer.ID, providerName),
+				attributes:   attributes,
+			})
+		}
+	}
+	return resources, nil
+}
+
+func exportBackupSchedules(projectID string, clusters []*matlas.AdvancedCluster) []projectExportResource {
+	var resources []projectExportResource
+	for _, cluster := range clusters {
+		if cluster.BackupEnabled == nil || !*cluster.BackupEnabled {
+			continue
+		}
+
+		resources = append(resources, projectExportResource{
+			resourceType: projectExportBackupScheduleType,
+			name:         cluster.Name,
+			importID:     fmt.Sprintf("%s-%s", projectID, cluster.Name),
+			attributes: [][2]string{
+				{"project_id", hclString(projectID)},
+				{"cluster_name", hclString(cluster.Name)},
+			},
+		})
+	}
+	return resources
+}
+
+// setProjectExportNames turns the names found in Atlas into valid and unique Terraform resource names.
+func setProjectExportNames(resources []projectExportResource) {
+	used := map[string]bool{}
+	for i := range resources {
+		name := strings.Trim(projectExportInvalidNameChars.ReplaceAllString(strings.ToLower(resources[i].name), "_"), "_")
+		if name == "" || (name[0] >= '0' && name[0] <= '9') {
+			name = "r_" + name
+		}
+
+		unique := name
+		for n := 2; used[resources[i].resourceType+"."+unique]; n++ {
+			unique = fmt.Sprintf("%s_%d", name, n)
+		}
+		used[resources[i].resourceType+"."+unique] = true
+		resources[i].name = unique
+	}
+}
+
+func flattenProjectExportResources(resources []projectExportResource) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(resources))
+	for i := range resources {
+		results = append(results, map[string]interface{}{
+			"type":      resources[i].resourceType,
+			"name":      resources[i].name,
+			"import_id": resources[i].importID,
+		})
+	}
+	return results
+}
+
+func projectExportImportBlocks(resources []projectExportResource) string {
+	blocks := make([]string, 0, len(resources))
+	for i := range resources {
+		blocks = append(blocks, hclBlock("import", [][2]string{
+			{"to", fmt.Sprintf("%s.%s", resources[i].resourceType, resources[i].name)},
+			{"id", hclString(resources[i].importID)},
+		}, nil))
+	}
+	return strings.Join(blocks, "\n")
+}
+
+func projectExportConfig(resources []projectExportResource) string {
+	blocks := make([]string, 0, len(resources))
+	for i := range resources {
+		blocks = append(blocks, hclBlock(fmt.Sprintf("resource %q %q", resources[i].resourceType, resources[i].name), resources[i].attributes, resources[i].blocks))
+	}
+	return strings.Join(blocks, "\n")
+}
+
+// hclString renders s as a quoted HCL string. Unlike Go quoting, it only uses the escape sequences HCL supports and
+// escapes the template sequences, so values such as passwords or comments containing "${" are kept literally.
+func hclString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			sb.WriteRune(r)
+			sb.WriteRune(r)
+		case r > 0xFFFF && !unicode.IsPrint(r):
+			fmt.Fprintf(&sb, `\U%08X`, r)
+		case r == utf8.RuneError || !unicode.IsPrint(r):
+			fmt.Fprintf(&sb, `\u%04X`, r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// hclBlock renders a block with the given attributes, whose values must already be HCL expressions, followed by
+// the given nested blocks, indented and aligned the same way as `terraform fmt` does.
+func hclBlock(header string, attributes [][2]string, nested []string) string {
+	width := 0
+	for _, attribute := range attributes {
+		if len(attribute[0]) > width {
+			width = len(attribute[0])
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(header + " {\n")
+	for _, attribute := range attributes {
+		fmt.Fprintf(&sb, "  %-*s = %s\n", width, attribute[0], attribute[1])
+	}
+	for _, block := range nested {
+		for _, line := range strings.Split(strings.TrimSuffix(block, "\n"), "\n") {
+			sb.WriteString("  " + line + "\n")
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
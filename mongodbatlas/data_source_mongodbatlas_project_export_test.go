@@ -0,0 +1,90 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectDSProjectExport_basic(t *testing.T) {
+	var (
+		dataSourceName = "data.mongodbatlas_project_export.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc")
+		username       = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMongoDBAtlasProjectExportConfig(orgID, projectName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "mongodbatlas_database_user"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.1.type", "mongodbatlas_project_ip_access_list"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.1.name", "r_10_0_0_0_24"),
+					resource.TestMatchResourceAttr(dataSourceName, "resources.1.import_id", regexp.MustCompile(`^[0-9a-f]{24}-10.0.0.0/24$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "import_blocks", regexp.MustCompile(`to = mongodbatlas_project_ip_access_list.r_10_0_0_0_24`)),
+					resource.TestMatchResourceAttr(dataSourceName, "config", regexp.MustCompile(`cidr_block = "10.0.0.0/24"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestHCLString(t *testing.T) {
+	testCases := map[string]string{
+		"plain":                   `"plain"`,
+		`quote " and \ backslash`: `"quote \" and \\ backslash"`,
+		"line\nbreak\ttab":        `"line\nbreak\ttab"`,
+		"${var.secret}":           `"$${var.secret}"`,
+		"%{ if true }":            `"%%{ if true }"`,
+		"100% $5 {x}":             `"100% $5 {x}"`,
+		"bell\a":                  `"bell\u0007"`,
+	}
+	for input, expected := range testCases {
+		if got := hclString(input); got != expected {
+			t.Errorf("hclString(%q) = %s, expected %s", input, got, expected)
+		}
+	}
+}
+
+func testAccDataSourceMongoDBAtlasProjectExportConfig(orgID, projectName, username string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  name   = %[2]q
+  org_id = %[1]q
+}
+
+resource "mongodbatlas_project_ip_access_list" "test" {
+  project_id = mongodbatlas_project.test.id
+  cidr_block = "10.0.0.0/24"
+}
+
+resource "mongodbatlas_database_user" "test" {
+  project_id         = mongodbatlas_project.test.id
+  username           = %[3]q
+  password           = "test-acc-password"
+  auth_database_name = "admin"
+
+  roles {
+    role_name     = "readAnyDatabase"
+    database_name = "admin"
+  }
+}
+
+data "mongodbatlas_project_export" "test" {
+  project_id     = mongodbatlas_project.test.id
+  resource_types = ["mongodbatlas_database_user", "mongodbatlas_project_ip_access_list"]
+
+  depends_on = [mongodbatlas_project_ip_access_list.test, mongodbatlas_database_user.test]
+}
+	`, orgID, projectName, username)
+}
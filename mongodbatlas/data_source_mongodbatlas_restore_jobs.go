@@ -0,0 +1,202 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	restoreJobStatusInProgress = "IN_PROGRESS"
+	restoreJobStatusCompleted  = "COMPLETED"
+	restoreJobStatusCancelled  = "CANCELLED"
+	restoreJobStatusExpired    = "EXPIRED"
+	restoreJobStatusFailed     = "FAILED"
+	restoreJobsItemsPerPage    = 500
+)
+
+func dataSourceMongoDBAtlasRestoreJobs() *schema.Resource {
+	restoreJobSchema := dataSourceMongoDBAtlasCloudBackupSnapshotRestoreJobs().Schema["results"].Elem.(*schema.Resource).Schema
+	restoreJobSchema["cluster_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	restoreJobSchema["status"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMongoDBAtlasRestoreJobsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					restoreJobStatusInProgress,
+					restoreJobStatusCompleted,
+					restoreJobStatusCancelled,
+					restoreJobStatusExpired,
+					restoreJobStatusFailed,
+				}, false),
+			},
+			"delivery_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"automated", "download", "pointInTime"}, false),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: restoreJobSchema,
+				},
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMongoDBAtlasRestoreJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	clusterNames, err := restoreJobsClusterNames(ctx, conn, projectID, d.Get("cluster_names").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting the clusters of project %s: %s", projectID, err))
+	}
+
+	status := d.Get("status").(string)
+	deliveryType := d.Get("delivery_type").(string)
+	results := make([]map[string]interface{}, 0)
+
+	for _, clusterName := range clusterNames {
+		restoreJobs, err := listClusterRestoreJobs(ctx, conn, projectID, clusterName)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error getting the restore jobs of cluster %s: %s", clusterName, err))
+		}
+
+		for _, restoreJob := range restoreJobs {
+			restoreJobStatus := getRestoreJobStatus(restoreJob)
+			if (status != "" && restoreJobStatus != status) || (deliveryType != "" && restoreJob.DeliveryType != deliveryType) {
+				continue
+			}
+
+			result := flattenCloudProviderSnapshotRestoreJobs([]*matlas.CloudProviderSnapshotRestoreJob{restoreJob})[0]
+			result["cluster_name"] = clusterName
+			result["status"] = restoreJobStatus
+			results = append(results, result)
+		}
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `results`: %s", err))
+	}
+
+	if err := d.Set("total_count", len(results)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `total_count`: %s", err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":    projectID,
+		"status":        status,
+		"delivery_type": deliveryType,
+	}))
+
+	return nil
+}
+
+// restoreJobsClusterNames returns the requested clusters, or every cluster of the project with Cloud Backup. Shared-tier
+// clusters are left out, as their restores are listed by mongodbatlas_shared_tier_restore_jobs.
+func restoreJobsClusterNames(ctx context.Context, conn *matlas.Client, projectID string, requested *schema.Set) ([]string, error) {
+	if requested.Len() > 0 {
+		clusterNames := make([]string, 0, requested.Len())
+		for _, clusterName := range requested.List() {
+			clusterNames = append(clusterNames, clusterName.(string))
+		}
+		return clusterNames, nil
+	}
+
+	var clusterNames []string
+	for page := 1; ; page++ {
+		clusters, _, err := conn.AdvancedClusters.List(ctx, projectID, &matlas.ListOptions{PageNum: page, ItemsPerPage: restoreJobsItemsPerPage})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range clusters.Results {
+			if cluster.BackupEnabled != nil && *cluster.BackupEnabled && !isSharedTierAdvancedCluster(cluster) {
+				clusterNames = append(clusterNames, cluster.Name)
+			}
+		}
+
+		if len(clusters.Results) < restoreJobsItemsPerPage {
+			return clusterNames, nil
+		}
+	}
+}
+
+func listClusterRestoreJobs(ctx context.Context, conn *matlas.Client, projectID, clusterName string) ([]*matlas.CloudProviderSnapshotRestoreJob, error) {
+	requestParameters := &matlas.SnapshotReqPathParameters{
+		GroupID:     projectID,
+		ClusterName: clusterName,
+	}
+
+	var restoreJobs []*matlas.CloudProviderSnapshotRestoreJob
+	for page := 1; ; page++ {
+		jobs, _, err := conn.CloudProviderSnapshotRestoreJobs.List(ctx, requestParameters, &matlas.ListOptions{PageNum: page, ItemsPerPage: restoreJobsItemsPerPage})
+		if err != nil {
+			return nil, err
+		}
+
+		restoreJobs = append(restoreJobs, jobs.Results...)
+		if len(jobs.Results) < restoreJobsItemsPerPage {
+			return restoreJobs, nil
+		}
+	}
+}
+
+// getRestoreJobStatus returns the status of a restore job, which Atlas only describes with its flags and dates.
+func getRestoreJobStatus(restoreJob *matlas.CloudProviderSnapshotRestoreJob) string {
+	switch {
+	case restoreJob.Failed != nil && *restoreJob.Failed:
+		return restoreJobStatusFailed
+	case restoreJob.Cancelled:
+		return restoreJobStatusCancelled
+	case restoreJob.Expired:
+		return restoreJobStatusExpired
+	case restoreJob.FinishedAt != "":
+		return restoreJobStatusCompleted
+	default:
+		return restoreJobStatusInProgress
+	}
+}
+
+func isSharedTierAdvancedCluster(cluster *matlas.AdvancedCluster) bool {
+	for _, replicationSpec := range cluster.ReplicationSpecs {
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig.ProviderName == "TENANT" {
+				return true
+			}
+		}
+	}
+	return false
+}
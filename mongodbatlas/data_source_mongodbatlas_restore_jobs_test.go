@@ -0,0 +1,44 @@
+package mongodbatlas
+
+import (
+	"testing"
+
+	"github.com/mwielbut/pointy"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestGetRestoreJobStatus(t *testing.T) {
+	testCases := []struct {
+		restoreJob *matlas.CloudProviderSnapshotRestoreJob
+		expected   string
+	}{
+		{
+			restoreJob: &matlas.CloudProviderSnapshotRestoreJob{},
+			expected:   restoreJobStatusInProgress,
+		},
+		{
+			restoreJob: &matlas.CloudProviderSnapshotRestoreJob{FinishedAt: "2023-10-01T10:00:00Z"},
+			expected:   restoreJobStatusCompleted,
+		},
+		{
+			restoreJob: &matlas.CloudProviderSnapshotRestoreJob{Cancelled: true},
+			expected:   restoreJobStatusCancelled,
+		},
+		{
+			restoreJob: &matlas.CloudProviderSnapshotRestoreJob{Expired: true, FinishedAt: "2023-10-01T10:00:00Z"},
+			expected:   restoreJobStatusExpired,
+		},
+		{
+			restoreJob: &matlas.CloudProviderSnapshotRestoreJob{Failed: pointy.Bool(true)},
+			expected:   restoreJobStatusFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			if got := getRestoreJobStatus(tc.restoreJob); got != tc.expected {
+				t.Errorf("getRestoreJobStatus() = %s, want %s", got, tc.expected)
+			}
+		})
+	}
+}
@@ -129,6 +129,12 @@ func flattenSearchIndexes(searchIndexes []*matlas.SearchIndex) ([]map[string]int
 			}
 			searchIndexesMap[i]["analyzers"] = searchIndexAnalyzers
 		}
+
+		latestDefinition, err := marshallSearchIndexDefinition(searchIndexes[i])
+		if err != nil {
+			return nil, err
+		}
+		searchIndexesMap[i]["latest_definition"] = latestDefinition
 	}
 
 	return searchIndexesMap, nil
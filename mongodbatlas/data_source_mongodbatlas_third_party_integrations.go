@@ -67,24 +67,7 @@ func integrationToSchema(d *schema.ResourceData, integration *matlas.ThirdPartyI
 	if integrationSchema.APIKey == "" {
 		integrationSchema.APIKey = integration.APIKey
 	}
-	if integrationSchema.ServiceKey == "" {
-		integrationSchema.APIKey = integration.ServiceKey
-	}
-	if integrationSchema.APIToken == "" {
-		integrationSchema.APIKey = integration.APIToken
-	}
-	if integrationSchema.RoutingKey == "" {
-		integrationSchema.APIKey = integration.RoutingKey
-	}
-	if integrationSchema.Secret == "" {
-		integrationSchema.APIKey = integration.Secret
-	}
-	if integrationSchema.Password == "" {
-		integrationSchema.APIKey = integration.Password
-	}
-	if integrationSchema.UserName == "" {
-		integrationSchema.APIKey = integration.UserName
-	}
+	// the other secrets (e.g. the webhook secret) are returned redacted, so they are only kept from the config
 	if integrationSchema.URL == "" {
 		integrationSchema.URL = integration.URL
 	}
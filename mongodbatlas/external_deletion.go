@@ -0,0 +1,81 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	externalDeletionSummary = "%s was deleted outside of Terraform"
+	externalDeletionWarning = "%s (%s) no longer exists in MongoDB Atlas, so it was removed from the state and will be " +
+		"planned for creation. Set `prevent_external_deletion_recovery` in the provider to fail the refresh instead."
+	externalDeletionError = "%s (%s) no longer exists in MongoDB Atlas. The refresh fails because the provider sets " +
+		"`prevent_external_deletion_recovery`, remove it from the state with `terraform state rm` or restore it in Atlas."
+)
+
+// removeExternallyDeletedResource handles a resource, or one of the entities its ID is made of, that returned 404
+// during a read. By default the resource is removed from the state with a warning so the next apply recreates it,
+// unless the provider sets prevent_external_deletion_recovery, in which case the read fails and the state is kept.
+func removeExternallyDeletedResource(d *schema.ResourceData, meta interface{}, resourceType string) diag.Diagnostics {
+	id := describeStateID(d.Id())
+	if preventExternalDeletionRecovery(meta) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf(externalDeletionSummary, resourceType),
+			Detail:   fmt.Sprintf(externalDeletionError, resourceType, id),
+		}}
+	}
+
+	d.SetId("")
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf(externalDeletionSummary, resourceType),
+		Detail:   fmt.Sprintf(externalDeletionWarning, resourceType, id),
+	}}
+}
+
+// removeExternallyDeletedFrameworkResource is the plugin framework counterpart of removeExternallyDeletedResource.
+func removeExternallyDeletedFrameworkResource(ctx context.Context, client *MongoDBClient, state *tfsdk.State, diags *fwdiag.Diagnostics, resourceType, stateID string) {
+	id := describeStateID(stateID)
+	if preventExternalDeletionRecovery(client) {
+		diags.AddError(fmt.Sprintf(externalDeletionSummary, resourceType), fmt.Sprintf(externalDeletionError, resourceType, id))
+		return
+	}
+
+	state.RemoveResource(ctx)
+	diags.AddWarning(fmt.Sprintf(externalDeletionSummary, resourceType), fmt.Sprintf(externalDeletionWarning, resourceType, id))
+}
+
+func preventExternalDeletionRecovery(meta interface{}) bool {
+	client, ok := meta.(*MongoDBClient)
+	return ok && client != nil && client.Config != nil && client.Config.PreventExternalDeletionRecovery
+}
+
+// describeStateID returns a readable form of an ID built with encodeStateID, e.g. `cluster_name=test, project_id=abc`,
+// or the ID itself when it isn't encoded.
+func describeStateID(stateID string) string {
+	ids := decodeStateID(stateID)
+	if len(ids) == 0 {
+		return stateID
+	}
+
+	keys := make([]string, 0, len(ids))
+	for key := range ids {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, fmt.Sprintf("%s=%s", key, ids[key]))
+	}
+
+	return strings.Join(values, ", ")
+}
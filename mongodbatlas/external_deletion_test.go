@@ -0,0 +1,72 @@
+package mongodbatlas
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDescribeStateID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		id       string
+		expected string
+	}{
+		{
+			name:     "encoded ID",
+			id:       encodeStateID(map[string]string{"project_id": "5d0f1f73cf09a29120e173cf", "cluster_name": "cluster0"}),
+			expected: "cluster_name=cluster0, project_id=5d0f1f73cf09a29120e173cf",
+		},
+		{
+			name:     "plain ID",
+			id:       "5d0f1f73cf09a29120e173cf",
+			expected: "5d0f1f73cf09a29120e173cf",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := describeStateID(tc.id); got != tc.expected {
+				t.Errorf("describeStateID() = %s, want %s", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRemoveExternallyDeletedResource(t *testing.T) {
+	testCases := []struct {
+		name             string
+		prevent          bool
+		expectedSeverity diag.Severity
+		expectedID       string
+	}{
+		{
+			name:             "removed with a warning",
+			expectedSeverity: diag.Warning,
+			expectedID:       "",
+		},
+		{
+			name:             "prevented recovery",
+			prevent:          true,
+			expectedSeverity: diag.Error,
+			expectedID:       "team-id",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+			d.SetId("team-id")
+			meta := &MongoDBClient{Config: &Config{PreventExternalDeletionRecovery: tc.prevent}}
+
+			diags := removeExternallyDeletedResource(d, meta, "mongodbatlas_team")
+			if len(diags) != 1 || diags[0].Severity != tc.expectedSeverity {
+				t.Fatalf("removeExternallyDeletedResource() = %v, want a single diagnostic with severity %v", diags, tc.expectedSeverity)
+			}
+			if d.Id() != tc.expectedID {
+				t.Errorf("resource ID = %q, want %q", d.Id(), tc.expectedID)
+			}
+		})
+	}
+}
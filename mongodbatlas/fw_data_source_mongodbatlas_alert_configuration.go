@@ -175,6 +175,9 @@ var alertConfigDSSchemaAttributes = map[string]schema.Attribute{
 				"email_enabled": schema.BoolAttribute{
 					Computed: true,
 				},
+				"integration_id": schema.StringAttribute{
+					Computed: true,
+				},
 				"interval_min": schema.Int64Attribute{
 					Computed: true,
 				},
@@ -264,7 +267,13 @@ func (d *AlertConfigurationDS) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	integrationIDs, err := getAlertConfigurationIntegrationIDs(ctx, d.client.Atlas, projectID, alertID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("error getting notification integration IDs", err.Error())
+	}
+
 	resultAlertConfigModel := newTFAlertConfigurationDSModel(alert, projectID)
+	setTFNotificationIntegrationIDs(resultAlertConfigModel.Notification, integrationIDs)
 	resultAlertConfigModel.Output = computeAlertConfigurationOutput(alert, outputs, *alert.EventTypeName)
 
 	// setting initial value for backwards compatibility, but setting the alert_configuration resource id here is not consistent with the resource
@@ -139,10 +139,18 @@ func (d *AlertConfigurationsDS) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	integrationIDs, err := listAlertConfigurationIntegrationIDs(ctx, d.client.Atlas, projectID, *params.PageNum, *params.ItemsPerPage)
+	if err != nil {
+		resp.Diagnostics.AddWarning("error getting notification integration IDs", err.Error())
+	}
+
 	alertConfigurationsConfig.ID = types.StringValue(encodeStateID(map[string]string{
 		"project_id": projectID,
 	}))
 	alertConfigurationsConfig.Results = newTFAlertConfigurationDSModelList(alerts.Results, projectID, alertConfigurationsConfig.OutputType)
+	for i := range alertConfigurationsConfig.Results {
+		setTFNotificationIntegrationIDs(alertConfigurationsConfig.Results[i].Notification, integrationIDs[alertConfigurationsConfig.Results[i].AlertConfigurationID.ValueString()])
+	}
 	if *params.IncludeCount {
 		alertConfigurationsConfig.TotalCount = types.Int64Value(int64(*alerts.TotalCount))
 	}
@@ -31,6 +31,7 @@ const (
 	DeprecationMessage                    = "this resource is deprecated and will be removed in %s, please transition to %s"
 	endPointSTSDefault                    = "https://sts.amazonaws.com"
 	MissingAuthAttrError                  = "either Atlas Programmatic API Keys or AWS Secrets Manager attributes must be set"
+	MissingSecretRegionError              = "`region` (or AWS_REGION) must be set to read Atlas Programmatic API Keys from AWS Secrets Manager"
 	ProviderConfigError                   = "error in configuring the provider."
 	AWS                                   = "AWS"
 	AZURE                                 = "AZURE"
@@ -53,7 +54,12 @@ type tfMongodbAtlasProviderModel struct {
 	AwsAccessKeyID       types.String `tfsdk:"aws_access_key_id"`
 	AwsSecretAccessKeyID types.String `tfsdk:"aws_secret_access_key"`
 	AwsSessionToken      types.String `tfsdk:"aws_session_token"`
+	UserAgentSuffix      types.String `tfsdk:"user_agent_suffix"`
 	IsMongodbGovCloud    types.Bool   `tfsdk:"is_mongodbgov_cloud"`
+	DebugLogging         types.Bool   `tfsdk:"debug_logging"`
+	DefaultTags          types.Map    `tfsdk:"default_tags"`
+
+	PreventExternalDeletionRecovery types.Bool `tfsdk:"prevent_external_deletion_recovery"`
 }
 
 type tfAssumeRoleModel struct {
@@ -106,7 +112,7 @@ func (p *MongodbtlasProvider) Schema(ctx context.Context, req provider.SchemaReq
 			},
 			"region": schema.StringAttribute{
 				Optional:    true,
-				Description: "Region where secret is stored as part of AWS Secret Manager.",
+				Description: "Region where secret is stored as part of AWS Secret Manager. Required when `secret_name` is set.",
 			},
 			"sts_endpoint": schema.StringAttribute{
 				Optional:    true,
@@ -124,6 +130,26 @@ func (p *MongodbtlasProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Optional:    true,
 				Description: "AWS Security Token Service provided session token.",
 			},
+			"user_agent_suffix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Value appended to the User-Agent of every request sent to MongoDB Atlas, e.g. for partner attribution.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^\r\n]*$`), "must not contain line breaks"),
+				},
+			},
+			"debug_logging": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Log the HTTP requests and responses sent to MongoDB Atlas with secrets redacted.",
+			},
+			"default_tags": schema.MapAttribute{
+				Optional:    true,
+				Description: "Tags applied to every cluster managed by the provider. Tags set in the resource take precedence.",
+				ElementType: types.StringType,
+			},
+			"prevent_external_deletion_recovery": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail the refresh of resources deleted outside of Terraform instead of removing them from the state with a warning.",
+			},
 		},
 	}
 }
@@ -204,21 +230,37 @@ func (p *MongodbtlasProvider) Configure(ctx context.Context, req provider.Config
 	var assumeRoles []tfAssumeRoleModel
 	data.AssumeRole.ElementsAs(ctx, &assumeRoles, true)
 	awsRoleDefined := len(assumeRoles) > 0
+	awsSecretDefined := awsRoleDefined || data.SecretName.ValueString() != ""
 
-	data = setDefaultValuesWithValidations(&data, awsRoleDefined, resp)
+	data = setDefaultValuesWithValidations(&data, awsSecretDefined, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	config := Config{
-		PublicKey:    data.PublicKey.ValueString(),
-		PrivateKey:   data.PrivateKey.ValueString(),
-		BaseURL:      data.BaseURL.ValueString(),
-		RealmBaseURL: data.RealmBaseURL.ValueString(),
+		PublicKey:       data.PublicKey.ValueString(),
+		PrivateKey:      data.PrivateKey.ValueString(),
+		BaseURL:         data.BaseURL.ValueString(),
+		RealmBaseURL:    data.RealmBaseURL.ValueString(),
+		UserAgentSuffix: data.UserAgentSuffix.ValueString(),
+		DebugLogging:    data.DebugLogging.ValueBool(),
+
+		PreventExternalDeletionRecovery: data.PreventExternalDeletionRecovery.ValueBool(),
+		AWSRegion:                       data.Region.ValueString(),
+	}
+
+	if !data.DefaultTags.IsNull() {
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &config.DefaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	if awsRoleDefined {
 		config.AssumeRole = parseTfModel(ctx, &assumeRoles[0])
+	}
+
+	if awsSecretDefined {
 		secret := data.SecretName.ValueString()
 		region := data.Region.ValueString()
 		awsAccessKeyID := data.AwsAccessKeyID.ValueString()
@@ -280,7 +322,7 @@ func parseTfModel(ctx context.Context, tfAssumeRoleModel *tfAssumeRoleModel) *As
 
 const MongodbGovCloudURL = "https://cloud.mongodbgov.com"
 
-func setDefaultValuesWithValidations(data *tfMongodbAtlasProviderModel, awsRoleDefined bool, resp *provider.ConfigureResponse) tfMongodbAtlasProviderModel {
+func setDefaultValuesWithValidations(data *tfMongodbAtlasProviderModel, awsSecretDefined bool, resp *provider.ConfigureResponse) tfMongodbAtlasProviderModel {
 	if mongodbgovCloud := data.IsMongodbGovCloud.ValueBool(); mongodbgovCloud {
 		data.BaseURL = types.StringValue(MongodbGovCloudURL)
 	}
@@ -296,7 +338,7 @@ func setDefaultValuesWithValidations(data *tfMongodbAtlasProviderModel, awsRoleD
 			"MONGODB_ATLAS_PUBLIC_KEY",
 			"MCLI_PUBLIC_API_KEY",
 		}, "").(string))
-		if data.PublicKey.ValueString() == "" && !awsRoleDefined {
+		if data.PublicKey.ValueString() == "" && !awsSecretDefined {
 			resp.Diagnostics.AddWarning(ProviderConfigError, MissingAuthAttrError)
 		}
 	}
@@ -306,7 +348,7 @@ func setDefaultValuesWithValidations(data *tfMongodbAtlasProviderModel, awsRoleD
 			"MONGODB_ATLAS_PRIVATE_KEY",
 			"MCLI_PRIVATE_API_KEY",
 		}, "").(string))
-		if data.PrivateKey.ValueString() == "" && !awsRoleDefined {
+		if data.PrivateKey.ValueString() == "" && !awsSecretDefined {
 			resp.Diagnostics.AddWarning(ProviderConfigError, MissingAuthAttrError)
 		}
 	}
@@ -352,6 +394,10 @@ func setDefaultValuesWithValidations(data *tfMongodbAtlasProviderModel, awsRoleD
 		}, "").(string))
 	}
 
+	if awsSecretDefined && data.Region.ValueString() == "" {
+		resp.Diagnostics.AddError(ProviderConfigError, MissingSecretRegionError)
+	}
+
 	return *data
 }
 
@@ -374,8 +420,11 @@ func (p *MongodbtlasProvider) Resources(context.Context) []func() resource.Resou
 		NewProjectRS,
 		NewEncryptionAtRestRS,
 		NewDatabaseUserRS,
+		NewDatabaseUsersRS,
 		NewAlertConfigurationRS,
 		NewProjectIPAccessListRS,
+		NewTeamRS,
+		NewTeamsRS,
 	}
 }
 
@@ -1,7 +1,9 @@
 package mongodbatlas
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -36,10 +38,48 @@ const (
 	victorOps                      = "VICTOR_OPS"
 	encodedIDKeyAlertID            = "id"
 	encodedIDKeyProjectID          = "project_id"
+	alertConfigurationsPath        = "api/atlas/v1.0/groups/%s/alertConfigs"
 )
 
+// notifierTypesWithoutInterval are the notification types for which Atlas ignores interval_min. For every other
+// type, Atlas rejects an interval_min lower than notificationIntervalMinMinimum.
+var notifierTypesWithoutInterval = []string{pagerDuty, opsGenie, victorOps}
+
+const notificationIntervalMinMinimum = 5
+
+// notifierTypesWithIntegration are the notification types that can reference a third-party integration by integration_id.
+var notifierTypesWithIntegration = []string{pagerDuty, opsGenie, victorOps, "SLACK", "DATADOG", "WEBHOOK", "MICROSOFT_TEAMS"}
+
+// alertMatcherFieldNames, alertMatcherOperators, alertThresholdOperators and alertThresholdUnits are the values
+// accepted by the Atlas API for matchers and thresholds.
+var alertMatcherFieldNames = []string{"TYPE_NAME", "HOSTNAME", "PORT", "HOSTNAME_AND_PORT", "REPLICA_SET_NAME", "SHARD_NAME", "CLUSTER_NAME", "APPLICATION_ID"}
+var alertMatcherOperators = []string{"EQUALS", "NOT_EQUALS", "CONTAINS", "NOT_CONTAINS", "STARTS_WITH", "ENDS_WITH", "REGEX"}
+var alertThresholdOperators = []string{"GREATER_THAN", "LESS_THAN"}
+var alertThresholdUnits = []string{
+	"RAW",
+	"BITS",
+	"BYTES",
+	"KILOBITS",
+	"KILOBYTES",
+	"MEGABITS",
+	"MEGABYTES",
+	"GIGABITS",
+	"GIGABYTES",
+	"TERABYTES",
+	"PETABYTES",
+	"MILLISECONDS",
+	"SECONDS",
+	"MINUTES",
+	"HOURS",
+	"DAYS",
+}
+
+// alertMatcherTypeNames are the values of a TYPE_NAME matcher, which can only be compared with EQUALS or NOT_EQUALS.
+var alertMatcherTypeNames = []string{"STANDALONE", "PRIMARY", "SECONDARY", "ARBITER", "MONGOS", "CONFIG"}
+
 var _ resource.ResourceWithConfigure = &AlertConfigurationRS{}
 var _ resource.ResourceWithImportState = &AlertConfigurationRS{}
+var _ resource.ResourceWithValidateConfig = &AlertConfigurationRS{}
 
 func NewAlertConfigurationRS() resource.Resource {
 	return &AlertConfigurationRS{
@@ -106,6 +146,7 @@ type tfNotificationModel struct {
 	TypeName                 types.String `tfsdk:"type_name"`
 	ChannelName              types.String `tfsdk:"channel_name"`
 	VictorOpsAPIKey          types.String `tfsdk:"victor_ops_api_key"`
+	IntegrationID            types.String `tfsdk:"integration_id"`
 	Roles                    []string     `tfsdk:"roles"`
 	IntervalMin              types.Int64  `tfsdk:"interval_min"`
 	DelayMin                 types.Int64  `tfsdk:"delay_min"`
@@ -160,9 +201,15 @@ func (r *AlertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 					Attributes: map[string]schema.Attribute{
 						"field_name": schema.StringAttribute{
 							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(alertMatcherFieldNames...),
+							},
 						},
 						"operator": schema.StringAttribute{
 							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(alertMatcherOperators...),
+							},
 						},
 						"value": schema.StringAttribute{
 							Required: true,
@@ -182,7 +229,7 @@ func (r *AlertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 						"operator": schema.StringAttribute{
 							Optional: true,
 							Validators: []validator.String{
-								stringvalidator.OneOf("GREATER_THAN", "LESS_THAN"),
+								stringvalidator.OneOf(alertThresholdOperators...),
 							},
 						},
 						"threshold": schema.Float64Attribute{
@@ -194,9 +241,15 @@ func (r *AlertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 						},
 						"units": schema.StringAttribute{
 							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(alertThresholdUnits...),
+							},
 						},
 						"mode": schema.StringAttribute{
 							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("AVERAGE"),
+							},
 						},
 					},
 				},
@@ -209,6 +262,9 @@ func (r *AlertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 					Attributes: map[string]schema.Attribute{
 						"operator": schema.StringAttribute{
 							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(alertThresholdOperators...),
+							},
 						},
 						"threshold": schema.Float64Attribute{
 							Optional: true,
@@ -220,23 +276,7 @@ func (r *AlertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 						"units": schema.StringAttribute{
 							Optional: true,
 							Validators: []validator.String{
-								stringvalidator.OneOf(
-									"RAW",
-									"BITS",
-									"BYTES",
-									"KILOBITS",
-									"KILOBYTES",
-									"MEGABITS",
-									"MEGABYTES",
-									"GIGABITS",
-									"GIGABYTES",
-									"TERABYTES",
-									"PETABYTES",
-									"MILLISECONDS",
-									"SECONDS",
-									"MINUTES",
-									"HOURS",
-									"DAYS"),
+								stringvalidator.OneOf(alertThresholdUnits...),
 							},
 						},
 					},
@@ -282,6 +322,9 @@ func (r *AlertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 								boolplanmodifier.UseStateForUnknown(),
 							},
 						},
+						"integration_id": schema.StringAttribute{
+							Optional: true,
+						},
 						"interval_min": schema.Int64Attribute{
 							Optional: true,
 							Computed: true,
@@ -392,7 +435,12 @@ func (r *AlertConfigurationRS) Create(ctx context.Context, req resource.CreateRe
 	}
 	apiReq.Notifications = notifications
 
-	apiResp, _, err := conn.AlertConfigurations.Create(ctx, projectID, apiReq)
+	var apiResp *matlas.AlertConfiguration
+	if integrationIDs := newNotificationIntegrationIDList(alertConfigPlan.Notification); integrationIDs != nil {
+		apiResp, err = upsertAlertConfigurationWithIntegrations(ctx, conn, http.MethodPost, fmt.Sprintf(alertConfigurationsPath, projectID), apiReq, integrationIDs)
+	} else {
+		apiResp, _, err = conn.AlertConfigurations.Create(ctx, projectID, apiReq)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(errorCreateAlertConf, err.Error())
 		return
@@ -423,11 +471,11 @@ func (r *AlertConfigurationRS) Read(ctx context.Context, req resource.ReadReques
 
 	ids := decodeStateID(alertConfigState.ID.ValueString())
 
-	alert, getResp, err := conn.AlertConfigurations.GetAnAlertConfig(context.Background(), ids[encodedIDKeyProjectID], ids[encodedIDKeyAlertID])
+	alert, integrationIDs, getResp, err := getAlertConfiguration(ctx, conn, ids[encodedIDKeyProjectID], ids[encodedIDKeyAlertID])
 	if err != nil {
 		// deleted in the backend case
 		if getResp != nil && getResp.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			removeExternallyDeletedFrameworkResource(ctx, r.client, &resp.State, &resp.Diagnostics, "mongodbatlas_alert_configuration", alertConfigState.ID.ValueString())
 			return
 		}
 		resp.Diagnostics.AddError(errorReadAlertConf, err.Error())
@@ -435,6 +483,7 @@ func (r *AlertConfigurationRS) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	newAlertConfigurationState := newTFAlertConfigurationModel(alert, &alertConfigState)
+	setTFNotificationIntegrationIDs(newAlertConfigurationState.Notification, integrationIDs)
 
 	// save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newAlertConfigurationState)...)
@@ -500,6 +549,9 @@ func (r *AlertConfigurationRS) Update(ctx context.Context, req resource.UpdateRe
 		reflect.DeepEqual(apiReq, &matlas.AlertConfiguration{Enabled: pointy.Bool(false)}) {
 		// this code seems unreachable, as notifications are always being set
 		updatedAlertConfigResp, _, err = conn.AlertConfigurations.EnableAnAlertConfig(ctx, ids[encodedIDKeyProjectID], ids[encodedIDKeyAlertID], apiReq.Enabled)
+	} else if integrationIDs := newNotificationIntegrationIDList(alertConfigPlan.Notification); integrationIDs != nil {
+		alertConfigPath := fmt.Sprintf(alertConfigurationsPath, ids[encodedIDKeyProjectID]) + "/" + ids[encodedIDKeyAlertID]
+		updatedAlertConfigResp, err = upsertAlertConfigurationWithIntegrations(ctx, conn, http.MethodPut, alertConfigPath, apiReq, integrationIDs)
 	} else {
 		updatedAlertConfigResp, _, err = conn.AlertConfigurations.Update(ctx, ids[encodedIDKeyProjectID], ids[encodedIDKeyAlertID], apiReq)
 	}
@@ -550,6 +602,237 @@ func (r *AlertConfigurationRS) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
 }
 
+func (r *AlertConfigurationRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateAlertMatchers(ctx, req, resp)
+
+	var notifications types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("notification"), &notifications)...)
+	if resp.Diagnostics.HasError() || notifications.IsNull() || notifications.IsUnknown() {
+		return
+	}
+
+	for i, elem := range notifications.Elements() {
+		notification, ok := elem.(types.Object)
+		if !ok || notification.IsNull() || notification.IsUnknown() {
+			continue
+		}
+		attrs := notification.Attributes()
+
+		typeName, ok := attrs["type_name"].(types.String)
+		if !ok || typeName.IsNull() || typeName.IsUnknown() {
+			continue
+		}
+
+		if intervalMin, ok := attrs["interval_min"].(types.Int64); ok && !intervalMin.IsNull() && !intervalMin.IsUnknown() {
+			switch {
+			case containsFold(notifierTypesWithoutInterval, typeName.ValueString()):
+				if intervalMin.ValueInt64() > 0 {
+					resp.Diagnostics.AddAttributeError(path.Root("notification").AtListIndex(i).AtName("interval_min"),
+						"invalid notification interval",
+						fmt.Sprintf("'interval_min' doesn't need to be set if type_name is %s, Atlas ignores it for this notifier", strings.Join(notifierTypesWithoutInterval, ", ")))
+				}
+			case intervalMin.ValueInt64() < notificationIntervalMinMinimum:
+				resp.Diagnostics.AddAttributeError(path.Root("notification").AtListIndex(i).AtName("interval_min"),
+					"invalid notification interval",
+					fmt.Sprintf("'interval_min' must be at least %d minutes if type_name is %s, got: %d", notificationIntervalMinMinimum, typeName.ValueString(), intervalMin.ValueInt64()))
+			}
+		}
+
+		if integrationID, ok := attrs["integration_id"].(types.String); ok && !integrationID.IsNull() && !integrationID.IsUnknown() {
+			if !containsFold(notifierTypesWithIntegration, typeName.ValueString()) {
+				resp.Diagnostics.AddAttributeError(path.Root("notification").AtListIndex(i).AtName("integration_id"),
+					"invalid notification integration",
+					fmt.Sprintf("'integration_id' can only be set if type_name is one of %s", strings.Join(notifierTypesWithIntegration, ", ")))
+			}
+		}
+	}
+}
+
+// validateAlertMatchers checks the combinations of field name, operator and value of the matchers that the schema
+// validators can't check on their own.
+func validateAlertMatchers(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var matchers types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("matcher"), &matchers)...)
+	if resp.Diagnostics.HasError() || matchers.IsNull() || matchers.IsUnknown() {
+		return
+	}
+
+	for i, elem := range matchers.Elements() {
+		matcher, ok := elem.(types.Object)
+		if !ok || matcher.IsNull() || matcher.IsUnknown() {
+			continue
+		}
+		attrs := matcher.Attributes()
+
+		fieldName, ok := attrs["field_name"].(types.String)
+		if !ok || fieldName.ValueString() != "TYPE_NAME" {
+			continue
+		}
+
+		operator, ok := attrs["operator"].(types.String)
+		if ok && !operator.IsNull() && !operator.IsUnknown() && operator.ValueString() != "EQUALS" && operator.ValueString() != "NOT_EQUALS" {
+			resp.Diagnostics.AddAttributeError(path.Root("matcher").AtListIndex(i).AtName("operator"),
+				"invalid matcher operator",
+				fmt.Sprintf("a TYPE_NAME matcher only supports the EQUALS and NOT_EQUALS operators, got: %s", operator.ValueString()))
+		}
+
+		value, ok := attrs["value"].(types.String)
+		if ok && !value.IsNull() && !value.IsUnknown() && !isElementExist(alertMatcherTypeNames, value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("matcher").AtListIndex(i).AtName("value"),
+				"invalid matcher value",
+				fmt.Sprintf("the value of a TYPE_NAME matcher must be one of %s, got: %s", strings.Join(alertMatcherTypeNames, ", "), value.ValueString()))
+		}
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// alertNotificationRequest extends matlas.Notification with the attributes not yet supported by the client.
+type alertNotificationRequest struct {
+	matlas.Notification
+	IntegrationID string `json:"integrationId,omitempty"`
+}
+
+// alertConfigurationRequest overrides the notifications of matlas.AlertConfiguration so integration IDs are sent to Atlas.
+type alertConfigurationRequest struct {
+	*matlas.AlertConfiguration
+	Notifications []alertNotificationRequest `json:"notifications,omitempty"`
+}
+
+// upsertAlertConfigurationWithIntegrations creates or updates an alert configuration whose notifications reference
+// third-party integrations, as matlas.Notification has no field for the integration ID.
+func upsertAlertConfigurationWithIntegrations(ctx context.Context, conn *matlas.Client, method, urlStr string,
+	apiReq *matlas.AlertConfiguration, integrationIDs []string) (*matlas.AlertConfiguration, error) {
+	body := &alertConfigurationRequest{
+		AlertConfiguration: apiReq,
+		Notifications:      make([]alertNotificationRequest, len(apiReq.Notifications)),
+	}
+	for i := range apiReq.Notifications {
+		body.Notifications[i] = alertNotificationRequest{
+			Notification:  apiReq.Notifications[i],
+			IntegrationID: integrationIDs[i],
+		}
+	}
+
+	httpReq, err := conn.NewRequest(ctx, method, urlStr, body)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(matlas.AlertConfiguration)
+	if _, err := conn.Do(ctx, httpReq, root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// alertConfigurationIntegrations holds the attributes of an alert configuration not yet supported by the clients.
+type alertConfigurationIntegrations struct {
+	ID            string `json:"id,omitempty"`
+	Notifications []struct {
+		IntegrationID string `json:"integrationId,omitempty"`
+	} `json:"notifications,omitempty"`
+}
+
+// alertConfigurationIntegrationsList is a page of alertConfigurationIntegrations.
+type alertConfigurationIntegrationsList struct {
+	Results []alertConfigurationIntegrations `json:"results,omitempty"`
+}
+
+func (a *alertConfigurationIntegrations) integrationIDs() []string {
+	integrationIDs := make([]string, len(a.Notifications))
+	for i := range a.Notifications {
+		integrationIDs[i] = a.Notifications[i].IntegrationID
+	}
+	return integrationIDs
+}
+
+// getAlertConfiguration gets an alert configuration along with the integration ID of each notification, which
+// matlas.Notification has no field for. Both are decoded from the same response.
+func getAlertConfiguration(ctx context.Context, conn *matlas.Client, projectID, alertID string) (*matlas.AlertConfiguration, []string, *matlas.Response, error) {
+	httpReq, err := conn.NewRequest(ctx, http.MethodGet, fmt.Sprintf(alertConfigurationsPath, projectID)+"/"+alertID, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	body := new(bytes.Buffer)
+	resp, err := conn.Do(ctx, httpReq, body)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	alert := new(matlas.AlertConfiguration)
+	if err := json.Unmarshal(body.Bytes(), alert); err != nil {
+		return nil, nil, resp, err
+	}
+	root := new(alertConfigurationIntegrations)
+	if err := json.Unmarshal(body.Bytes(), root); err != nil {
+		return nil, nil, resp, err
+	}
+
+	return alert, root.integrationIDs(), resp, nil
+}
+
+// getAlertConfigurationIntegrationIDs returns the integration ID of each notification of an alert configuration,
+// as neither matlas.Notification nor admin.AlertsNotificationRootForGroup have a field for it.
+func getAlertConfigurationIntegrationIDs(ctx context.Context, conn *matlas.Client, projectID, alertID string) ([]string, error) {
+	_, integrationIDs, _, err := getAlertConfiguration(ctx, conn, projectID, alertID)
+	return integrationIDs, err
+}
+
+// listAlertConfigurationIntegrationIDs returns the integration IDs of the notifications of a page of alert
+// configurations, keyed by alert configuration ID.
+func listAlertConfigurationIntegrationIDs(ctx context.Context, conn *matlas.Client, projectID string, pageNum, itemsPerPage int) (map[string][]string, error) {
+	httpReq, err := conn.NewRequest(ctx, http.MethodGet,
+		fmt.Sprintf(alertConfigurationsPath+"?pageNum=%d&itemsPerPage=%d", projectID, pageNum, itemsPerPage), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(alertConfigurationIntegrationsList)
+	if _, err := conn.Do(ctx, httpReq, root); err != nil {
+		return nil, err
+	}
+
+	integrationIDs := make(map[string][]string, len(root.Results))
+	for i := range root.Results {
+		integrationIDs[root.Results[i].ID] = root.Results[i].integrationIDs()
+	}
+	return integrationIDs, nil
+}
+
+// setTFNotificationIntegrationIDs sets the integration ID returned by Atlas in each notification.
+func setTFNotificationIntegrationIDs(notifications []tfNotificationModel, integrationIDs []string) {
+	for i := range notifications {
+		if i < len(integrationIDs) {
+			notifications[i].IntegrationID = conversion.StringNullIfEmpty(integrationIDs[i])
+		}
+	}
+}
+
+// newNotificationIntegrationIDList returns the integration ID of each notification, or nil if none of them references an integration.
+func newNotificationIntegrationIDList(tfNotificationSlice []tfNotificationModel) []string {
+	integrationIDs := make([]string, len(tfNotificationSlice))
+	found := false
+	for i := range tfNotificationSlice {
+		integrationIDs[i] = tfNotificationSlice[i].IntegrationID.ValueString()
+		found = found || integrationIDs[i] != ""
+	}
+
+	if !found {
+		return nil
+	}
+	return integrationIDs
+}
+
 func newNotificationList(tfNotificationSlice []tfNotificationModel) ([]matlas.Notification, error) {
 	notifications := make([]matlas.Notification, len(tfNotificationSlice))
 	if len(tfNotificationSlice) == 0 {
@@ -697,6 +980,9 @@ func newTFNotificationModelList(matlasSlice []matlas.Notification, currStateNoti
 		newState.WebhookSecret = conversion.StringNullIfEmpty(currState.WebhookSecret.ValueString())
 		newState.MicrosoftTeamsWebhookURL = conversion.StringNullIfEmpty(currState.MicrosoftTeamsWebhookURL.ValueString())
 
+		// integration ID is not returned by the client, the value defined in the configuration is kept until it is read from Atlas
+		newState.IntegrationID = conversion.StringNullIfEmpty(currState.IntegrationID.ValueString())
+
 		// for optional attributes that are not computed we must check if they were previously defined in state
 		if !currState.ChannelName.IsNull() {
 			newState.ChannelName = conversion.StringNullIfEmpty(value.ChannelName)
@@ -502,6 +502,65 @@ func TestAccConfigRSAlertConfiguration_VictorOps(t *testing.T) {
 	})
 }
 
+func TestAccConfigRSAlertConfiguration_InvalidIntervalMin(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasAlertConfigurationIntervalMinConfig(orgID, projectName, "PAGER_DUTY", 5),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("doesn't need to be set if type_name is"),
+			},
+			{
+				Config:      testAccMongoDBAtlasAlertConfigurationIntervalMinConfig(orgID, projectName, "GROUP", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be at least 5 minutes if type_name is GROUP"),
+			},
+		},
+	})
+}
+
+func TestAccConfigRSAlertConfiguration_InvalidMatcher(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAlertConfigurationConfigWithMatchers(orgID, projectName, true, false, true,
+					matlas.Matcher{FieldName: "HOST_NAME", Operator: "EQUALS", Value: "SECONDARY"},
+					matlas.Matcher{FieldName: "REPLICA_SET_NAME", Operator: "EQUALS", Value: "rs"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`matcher\[0\].field_name value must be one of`),
+			},
+			{
+				Config: testAccMongoDBAtlasAlertConfigurationConfigWithMatchers(orgID, projectName, true, false, true,
+					matlas.Matcher{FieldName: "TYPE_NAME", Operator: "CONTAINS", Value: "SECOND"},
+					matlas.Matcher{FieldName: "REPLICA_SET_NAME", Operator: "EQUALS", Value: "rs"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("a TYPE_NAME matcher only supports the EQUALS and NOT_EQUALS operators"),
+			},
+			{
+				Config: testAccMongoDBAtlasAlertConfigurationConfigWithMatchers(orgID, projectName, true, false, true,
+					matlas.Matcher{FieldName: "TYPE_NAME", Operator: "EQUALS", Value: "LEADER"},
+					matlas.Matcher{FieldName: "REPLICA_SET_NAME", Operator: "EQUALS", Value: "rs"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the value of a TYPE_NAME matcher must be one of"),
+			},
+		},
+	})
+}
+
 func testAccCheckMongoDBAtlasAlertConfigurationExists(resourceName string, alert *matlas.AlertConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testMongoDBClient.(*MongoDBClient).Atlas
@@ -946,3 +1005,25 @@ resource "mongodbatlas_alert_configuration" "test" {
 }
 	`, orgID, projectName, enabled)
 }
+
+func testAccMongoDBAtlasAlertConfigurationIntervalMinConfig(orgID, projectName, typeName string, intervalMin int) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_alert_configuration" "test" {
+  project_id = mongodbatlas_project.test.id
+  event_type = "NO_PRIMARY"
+  enabled    = true
+
+  notification {
+    type_name    = %[3]q
+    service_key  = "dummy"
+    roles        = ["GROUP_OWNER"]
+    interval_min = %[4]d
+    delay_min    = 0
+  }
+}
+	`, orgID, projectName, typeName, intervalMin)
+}
@@ -2,10 +2,16 @@ package mongodbatlas
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"regexp"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -22,7 +28,11 @@ import (
 )
 
 const (
-	databaseUserResourceName = "database_user"
+	databaseUserResourceName  = "database_user"
+	databaseUserPath          = "api/atlas/v1.0/groups/%s/databaseUsers/%s/%s"
+	databaseUserScopeCluster  = "CLUSTER"
+	databaseUserScopeDataLake = "DATA_LAKE"
+	errorDatabaseUserScopes   = "database user scopes may not grant access"
 )
 
 var _ resource.ResourceWithConfigure = &DatabaseUserRS{}
@@ -46,6 +56,8 @@ type tfDatabaseUserModel struct {
 	AuthDatabaseName types.String `tfsdk:"auth_database_name"`
 	Username         types.String `tfsdk:"username"`
 	Password         types.String `tfsdk:"password"`
+	PasswordSecretID types.String `tfsdk:"password_secret_id"`
+	PasswordRotation types.Map    `tfsdk:"password_rotation"`
 	X509Type         types.String `tfsdk:"x509_type"`
 	OIDCAuthType     types.String `tfsdk:"oidc_auth_type"`
 	LDAPAuthType     types.String `tfsdk:"ldap_auth_type"`
@@ -119,9 +131,17 @@ func (r *DatabaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 						path.MatchRelative().AtParent().AtName("x509_type"),
 						path.MatchRelative().AtParent().AtName("ldap_auth_type"),
 						path.MatchRelative().AtParent().AtName("aws_iam_type"),
+						path.MatchRelative().AtParent().AtName("password_secret_id"),
 					}...),
 				},
 			},
+			"password_secret_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"password_rotation": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"x509_type": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -196,6 +216,9 @@ func (r *DatabaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 						"type": schema.StringAttribute{
 							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(databaseUserScopeCluster, databaseUserScopeDataLake),
+							},
 						},
 					},
 				},
@@ -219,6 +242,19 @@ func (r *DatabaseUserRS) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if err := validateDatabaseUserScopes(ctx, r.client, databaseUserPlan.ProjectID.ValueString(), dbUserReq.Scopes); err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("scopes"), errorDatabaseUserScopes, err.Error())
+	}
+
+	if secretID := databaseUserPlan.PasswordSecretID.ValueString(); secretID != "" {
+		password, err := getDatabaseUserPasswordFromSecret(secretID, r.client.Config.AWSRegion)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("password_secret_id"), "error reading the database user password", err.Error())
+			return
+		}
+		dbUserReq.Password = password
+	}
+
 	conn := r.client.Atlas
 	dbUser, _, err := conn.DatabaseUsers.Create(ctx, databaseUserPlan.ProjectID.ValueString(), dbUserReq)
 	if err != nil {
@@ -300,6 +336,28 @@ func (r *DatabaseUserRS) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if err := validateDatabaseUserScopes(ctx, r.client, databaseUserPlan.ProjectID.ValueString(), dbUserReq.Scopes); err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("scopes"), errorDatabaseUserScopes, err.Error())
+	}
+
+	var databaseUserState *tfDatabaseUserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &databaseUserState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The secret is only read again when the rotation keepers or the secret change, so rotating the secret
+	// doesn't change the password of the user until the rotation is applied.
+	if secretID := databaseUserPlan.PasswordSecretID.ValueString(); secretID != "" &&
+		(!databaseUserPlan.PasswordRotation.Equal(databaseUserState.PasswordRotation) || !databaseUserPlan.PasswordSecretID.Equal(databaseUserState.PasswordSecretID)) {
+		password, err := getDatabaseUserPasswordFromSecret(secretID, r.client.Config.AWSRegion)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("password_secret_id"), "error reading the database user password", err.Error())
+			return
+		}
+		dbUserReq.Password = password
+	}
+
 	conn := r.client.Atlas
 	dbUser, _, err := conn.DatabaseUsers.Update(ctx, databaseUserPlan.ProjectID.ValueString(), databaseUserPlan.Username.ValueString(), dbUserReq)
 	if err != nil {
@@ -307,6 +365,15 @@ func (r *DatabaseUserRS) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// The update leaves the scopes untouched when none are sent, so removing all of them needs an explicit empty list.
+	if len(dbUserReq.Scopes) == 0 && len(databaseUserState.Scopes.Elements()) > 0 {
+		dbUser, err = clearDatabaseUserScopes(ctx, conn, dbUser)
+		if err != nil {
+			resp.Diagnostics.AddError("error removing the database user scopes", err.Error())
+			return
+		}
+	}
+
 	dbUserModel, diagnostic := newTFDatabaseUserModel(ctx, databaseUserPlan, dbUser)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
@@ -415,6 +482,14 @@ func newTFDatabaseUserModel(ctx context.Context, model *tfDatabaseUserModel, dbU
 		databaseUserModel.Password = model.Password
 	}
 
+	databaseUserModel.PasswordRotation = types.MapNull(types.StringType)
+	if model != nil {
+		databaseUserModel.PasswordSecretID = model.PasswordSecretID
+		if !model.PasswordRotation.IsNull() {
+			databaseUserModel.PasswordRotation = model.PasswordRotation
+		}
+	}
+
 	return databaseUserModel, nil
 }
 
@@ -519,6 +594,87 @@ func newMongoDBAtlasScopes(scopes []*tfScopeModel) []matlas.Scope {
 	return out
 }
 
+// validateDatabaseUserScopes checks that every scope matches a cluster, serverless instance or federated database
+// instance of the project, as Atlas otherwise accepts the user and the scope silently grants no access. The callers only
+// warn about an invalid scope, since the cluster may be created later by another configuration.
+func validateDatabaseUserScopes(ctx context.Context, client *MongoDBClient, projectID string, scopes []matlas.Scope) error {
+	for _, scope := range scopes {
+		switch scope.Type {
+		case databaseUserScopeCluster:
+			_, resp, err := client.Atlas.AdvancedClusters.Get(ctx, projectID, scope.Name)
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				_, resp, err = client.Atlas.ServerlessInstances.Get(ctx, projectID, scope.Name)
+			}
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("scope %q doesn't match any cluster in project (%s)", scope.Name, projectID)
+			}
+			if err != nil {
+				return err
+			}
+		case databaseUserScopeDataLake:
+			_, resp, err := client.AtlasV2.DataFederationApi.GetFederatedDatabase(ctx, projectID, scope.Name).Execute()
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("scope %q doesn't match any data lake in project (%s)", scope.Name, projectID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func clearDatabaseUserScopes(ctx context.Context, conn *matlas.Client, dbUser *matlas.DatabaseUser) (*matlas.DatabaseUser, error) {
+	body := map[string]interface{}{
+		"scopes": []matlas.Scope{},
+	}
+	req, err := conn.NewRequest(ctx, http.MethodPatch, fmt.Sprintf(databaseUserPath, dbUser.GroupID, dbUser.DatabaseName, url.PathEscape(dbUser.Username)), body)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(matlas.DatabaseUser)
+	if _, err = conn.Do(ctx, req, root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// getDatabaseUserPasswordFromSecret reads the password from AWS Secrets Manager using the AWS default credential
+// chain. The secret is read from the region of its ARN, or from the region of the provider when it's referenced by
+// name. The secret can hold the password itself or a JSON document with a `password` field.
+func getDatabaseUserPasswordFromSecret(secretID, providerRegion string) (string, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	secretConfig := &aws.Config{}
+	if secretARN, parseErr := arn.Parse(secretID); parseErr == nil {
+		secretConfig.Region = aws.String(secretARN.Region)
+	} else if providerRegion != "" {
+		secretConfig.Region = aws.String(providerRegion)
+	}
+
+	secretString, err := secretsManagerGetSecretValue(sess, secretConfig, secretID)
+	if err != nil {
+		return "", err
+	}
+
+	var secretData struct {
+		Password string `json:"password"`
+	}
+	if json.Unmarshal([]byte(secretString), &secretData) == nil && secretData.Password != "" {
+		return secretData.Password, nil
+	}
+
+	return secretString, nil
+}
+
 func splitDatabaseUserImportID(id string) (projectID, username, authDatabaseName string, err error) {
 	var re = regexp.MustCompile(`(?s)^([0-9a-fA-F]{24})-(.*)-([$a-z]{1,15})$`)
 	parts := re.FindStringSubmatch(id)
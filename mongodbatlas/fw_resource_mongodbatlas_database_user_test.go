@@ -2,6 +2,7 @@ package mongodbatlas
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -13,6 +14,13 @@ import (
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+func init() {
+	resource.AddTestSweepers("mongodbatlas_database_user", &resource.Sweeper{
+		Name: "mongodbatlas_database_user",
+		F:    testSweepDatabaseUsers,
+	})
+}
+
 func TestAccConfigRSDatabaseUser_basic(t *testing.T) {
 	var (
 		dbUser       matlas.DatabaseUser
@@ -352,7 +360,7 @@ func TestAccConfigRSDatabaseUser_withScopes(t *testing.T) {
 		CheckDestroy:             testAccCheckMongoDBAtlasDatabaseUserDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMongoDBAtlasDatabaseUserWithScopes(username, password, projectName, orgID, "atlasAdmin", clusterName,
+				Config: testAccMongoDBAtlasDatabaseUserWithDataLakeScopes(username, password, projectName, orgID, "atlasAdmin", clusterName,
 					[]*matlas.Scope{
 						{
 							Name: "test-acc-nurk4llu2z",
@@ -375,7 +383,7 @@ func TestAccConfigRSDatabaseUser_withScopes(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccMongoDBAtlasDatabaseUserWithScopes(username, password, projectName, orgID, "atlasAdmin", clusterName,
+				Config: testAccMongoDBAtlasDatabaseUserWithDataLakeScopes(username, password, projectName, orgID, "atlasAdmin", clusterName,
 					[]*matlas.Scope{
 						{
 							Name: "test-acc-nurk4llu2z",
@@ -414,7 +422,7 @@ func TestAccConfigRSDatabaseUser_withScopesAndEmpty(t *testing.T) {
 		CheckDestroy:             testAccCheckMongoDBAtlasDatabaseUserDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMongoDBAtlasDatabaseUserWithScopes(username, password, projectName, orgID, "atlasAdmin", clusterName,
+				Config: testAccMongoDBAtlasDatabaseUserWithDataLakeScopes(username, password, projectName, orgID, "atlasAdmin", clusterName,
 					[]*matlas.Scope{
 						{
 							Name: "test-acc-nurk4llu2z",
@@ -437,7 +445,7 @@ func TestAccConfigRSDatabaseUser_withScopesAndEmpty(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccMongoDBAtlasDatabaseUserWithScopes(username, password, projectName, orgID, "atlasAdmin", clusterName,
+				Config: testAccMongoDBAtlasDatabaseUserWithDataLakeScopes(username, password, projectName, orgID, "atlasAdmin", clusterName,
 					[]*matlas.Scope{},
 				),
 				Check: resource.ComposeTestCheckFunc(
@@ -454,6 +462,67 @@ func TestAccConfigRSDatabaseUser_withScopesAndEmpty(t *testing.T) {
 	})
 }
 
+func TestAccConfigRSDatabaseUser_withScopesNotFound(t *testing.T) {
+	var (
+		dbUser       matlas.DatabaseUser
+		resourceName = "mongodbatlas_database_user.test"
+		username     = acctest.RandomWithPrefix("test-acc-user-")
+		password     = acctest.RandomWithPrefix("test-acc-pass-")
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the provider only warns about the scope, as the cluster may be created later
+				Config: testAccMongoDBAtlasDatabaseUserWithUnknownScopeConfig(username, password, projectName, orgID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasDatabaseUserExists(resourceName, &dbUser),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.0.name", "missing-cluster"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigRSDatabaseUser_withPasswordRotation(t *testing.T) {
+	var (
+		dbUser       matlas.DatabaseUser
+		resourceName = "mongodbatlas_database_user.test"
+		username     = acctest.RandomWithPrefix("dbUser")
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasDatabaseUserWithPasswordRotationConfig(projectName, orgID, username, "test-acc-password", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasDatabaseUserExists(resourceName, &dbUser),
+					resource.TestCheckResourceAttr(resourceName, "password_rotation.version", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasDatabaseUserWithPasswordRotationConfig(projectName, orgID, username, "test-acc-password-rotated", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasDatabaseUserExists(resourceName, &dbUser),
+					resource.TestCheckResourceAttr(resourceName, "password", "test-acc-password-rotated"),
+					resource.TestCheckResourceAttr(resourceName, "password_rotation.version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccConfigRSDatabaseUser_withLDAPAuthType(t *testing.T) {
 	var (
 		dbUser       matlas.DatabaseUser
@@ -882,6 +951,138 @@ func testAccMongoDBAtlasDatabaseUserWithScopes(username, password, projectName,
 	`, projectName, orgID, clusterName, username, password, roleName, scopes)
 }
 
+func testAccMongoDBAtlasDatabaseUserWithDataLakeScopes(username, password, projectName, orgID, roleName, clusterName string, scopesArr []*matlas.Scope) string {
+	// the DATA_LAKE scopes refer to a federated database instance, as scopes must match a cluster or data lake of the project
+	var scopes string
+
+	for _, scope := range scopesArr {
+		var scopeType string
+		scopeName := "${mongodbatlas_cluster.my_cluster.name}"
+
+		if scope.Type != "" {
+			scopeType = fmt.Sprintf(`type = %q`, scope.Type)
+		}
+		if scope.Type == "DATA_LAKE" {
+			scopeName = "${mongodbatlas_federated_database_instance.test.name}"
+		}
+
+		scopes += fmt.Sprintf(`
+			scopes {
+				name = %q
+				%s
+			}
+		`, scopeName, scopeType)
+	}
+
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = "%s"
+			org_id = "%s"
+		}
+
+		resource "mongodbatlas_cluster" "my_cluster" {
+			project_id   = "${mongodbatlas_project.test.id}"
+			name         = "%s"
+			
+			// Provider Settings "block"
+			provider_name               = "AWS"
+			provider_region_name        = "US_EAST_2"
+			provider_instance_size_name = "M10"
+			cloud_backup                = true //enable cloud provider snapshots
+		}
+
+		resource "mongodbatlas_federated_database_instance" "test" {
+			project_id = "${mongodbatlas_project.test.id}"
+			name       = "${mongodbatlas_cluster.my_cluster.name}-fdi"
+
+			storage_databases {
+				name = "VirtualDatabase0"
+				collections {
+					name = "VirtualCollection0"
+					data_sources {
+						collection = "listingsAndReviews"
+						database   = "sample_airbnb"
+						store_name = "${mongodbatlas_cluster.my_cluster.name}"
+					}
+				}
+			}
+
+			storage_stores {
+				name         = "${mongodbatlas_cluster.my_cluster.name}"
+				cluster_name = "${mongodbatlas_cluster.my_cluster.name}"
+				project_id   = "${mongodbatlas_project.test.id}"
+				provider     = "atlas"
+			}
+		}
+
+		resource "mongodbatlas_database_user" "test" {
+			username           = "%s"
+			password           = "%s"
+			project_id         = "${mongodbatlas_project.test.id}"
+			auth_database_name = "admin"
+
+			roles {
+				role_name     = "%s"
+				database_name = "admin"
+			}
+
+			%s
+
+		}
+	`, projectName, orgID, clusterName, username, password, roleName, scopes)
+}
+
+func testAccMongoDBAtlasDatabaseUserWithUnknownScopeConfig(username, password, projectName, orgID string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[3]q
+			org_id = %[4]q
+		}
+
+		resource "mongodbatlas_database_user" "test" {
+			username           = %[1]q
+			password           = %[2]q
+			project_id         = mongodbatlas_project.test.id
+			auth_database_name = "admin"
+
+			roles {
+				role_name     = "atlasAdmin"
+				database_name = "admin"
+			}
+
+			scopes {
+				name = "missing-cluster"
+				type = "CLUSTER"
+			}
+		}
+	`, username, password, projectName, orgID)
+}
+
+func testAccMongoDBAtlasDatabaseUserWithPasswordRotationConfig(projectName, orgID, username, password, version string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[1]q
+			org_id = %[2]q
+		}
+
+		resource "mongodbatlas_database_user" "test" {
+			username           = %[3]q
+			password           = %[4]q
+			project_id         = mongodbatlas_project.test.id
+			auth_database_name = "admin"
+
+			password_rotation = {
+				version = %[5]q
+			}
+
+			roles {
+				role_name     = "read"
+				database_name = "admin"
+			}
+		}
+	`, projectName, orgID, username, password, version)
+}
+
 func testAccMongoDBAtlasDatabaseUserWithLDAPAuthTypeConfig(projectName, orgID, roleName, username, keyLabel, valueLabel string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_project" "test" {
@@ -907,3 +1108,45 @@ func testAccMongoDBAtlasDatabaseUserWithLDAPAuthTypeConfig(projectName, orgID, r
 		}
 	`, projectName, orgID, roleName, username, keyLabel, valueLabel)
 }
+
+// testSweepDatabaseUsers deletes the database users the acceptance tests created in the shared project. The users of
+// the projects created by the tests are deleted along with them.
+func testSweepDatabaseUsers(_ string) error {
+	client, orgID, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	conn := client.Atlas
+	projectIDs, err := sweepableProjectIDs(ctx, conn, orgID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for projectID, sweepAll := range projectIDs {
+		if sweepAll {
+			continue
+		}
+
+		users, _, err := conn.DatabaseUsers.List(ctx, projectID, &matlas.ListOptions{ItemsPerPage: sweeperItemsPerPage})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error listing the database users of project %s: %s", projectID, err))
+			continue
+		}
+
+		for i := range users {
+			if !isSweepable(users[i].Username) {
+				continue
+			}
+
+			log.Printf("[INFO] sweeping database user %s of project %s", users[i].Username, projectID)
+			if _, err := conn.DatabaseUsers.Delete(ctx, users[i].DatabaseName, projectID, users[i].Username); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting database user %s of project %s: %s", users[i].Username, projectID, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,634 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	databaseUsersResourceName   = "database_users"
+	databaseUsersMaxParallelism = 50
+	databaseUsersItemsPerPage   = 500
+)
+
+var _ resource.ResourceWithConfigure = &DatabaseUsersRS{}
+var _ resource.ResourceWithImportState = &DatabaseUsersRS{}
+
+// DatabaseUsersRS manages a batch of database users of a project, applying the changes to the users concurrently.
+type DatabaseUsersRS struct {
+	RSCommon
+}
+
+func NewDatabaseUsersRS() resource.Resource {
+	return &DatabaseUsersRS{
+		RSCommon: RSCommon{
+			resourceName: databaseUsersResourceName,
+		},
+	}
+}
+
+type tfDatabaseUsersModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Users       types.Set    `tfsdk:"users"`
+	Parallelism types.Int64  `tfsdk:"parallelism"`
+}
+
+type tfBatchDatabaseUserModel struct {
+	AuthDatabaseName types.String `tfsdk:"auth_database_name"`
+	Username         types.String `tfsdk:"username"`
+	Password         types.String `tfsdk:"password"`
+	X509Type         types.String `tfsdk:"x509_type"`
+	OIDCAuthType     types.String `tfsdk:"oidc_auth_type"`
+	LDAPAuthType     types.String `tfsdk:"ldap_auth_type"`
+	AWSIAMType       types.String `tfsdk:"aws_iam_type"`
+	Roles            types.Set    `tfsdk:"roles"`
+	Labels           types.Set    `tfsdk:"labels"`
+	Scopes           types.Set    `tfsdk:"scopes"`
+}
+
+var BatchDatabaseUserObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"auth_database_name": types.StringType,
+	"username":           types.StringType,
+	"password":           types.StringType,
+	"x509_type":          types.StringType,
+	"oidc_auth_type":     types.StringType,
+	"ldap_auth_type":     types.StringType,
+	"aws_iam_type":       types.StringType,
+	"roles":              types.SetType{ElemType: RoleObjectType},
+	"labels":             types.SetType{ElemType: LabelObjectType},
+	"scopes":             types.SetType{ElemType: ScopeObjectType},
+}}
+
+func (r *DatabaseUsersRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parallelism": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, databaseUsersMaxParallelism),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"users": schema.SetNestedBlock{
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_database_name": schema.StringAttribute{
+							Required: true,
+						},
+						"username": schema.StringAttribute{
+							Required: true,
+						},
+						"password": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+						},
+						"x509_type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("NONE"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("NONE", "MANAGED", "CUSTOMER"),
+							},
+						},
+						"oidc_auth_type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("NONE"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("NONE", "IDP_GROUP"),
+							},
+						},
+						"ldap_auth_type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("NONE"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("NONE", "USER", "GROUP"),
+							},
+						},
+						"aws_iam_type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("NONE"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("NONE", "USER", "ROLE"),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"roles": schema.SetNestedBlock{
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"collection_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"database_name": schema.StringAttribute{
+										Required: true,
+									},
+									"role_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"labels": schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Optional: true,
+									},
+									"value": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"scopes": schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Optional: true,
+									},
+									"type": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(databaseUserScopeCluster, databaseUserScopeDataLake),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabaseUsersRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *tfDatabaseUsersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	users, d := newMongoDBBatchDatabaseUsers(ctx, projectID, plan.Users)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warnDatabaseUsersScopes(ctx, r.client, projectID, users, &resp.Diagnostics)
+
+	conn := r.client.Atlas
+	created, errs := applyDatabaseUsersConcurrently(int(plan.Parallelism.ValueInt64()), users, func(user *matlas.DatabaseUser) (*matlas.DatabaseUser, error) {
+		dbUser, _, err := conn.DatabaseUsers.Create(ctx, projectID, user)
+		return dbUser, err
+	})
+	appendDatabaseUsersErrors(&resp.Diagnostics, "error during database user creation", errs)
+
+	// The users that were created are kept in the state even if others failed, so they are not orphaned.
+	state, d := newTFDatabaseUsersModel(ctx, plan, created, nil)
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *DatabaseUsersRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *tfDatabaseUsersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the ID only with the IMPORT operation
+	projectID := state.ProjectID.ValueString()
+	if projectID == "" {
+		projectID = state.ID.ValueString()
+		state.ProjectID = types.StringValue(projectID)
+		state.Parallelism = types.Int64Value(10)
+	}
+
+	conn := r.client.Atlas
+	var users []*matlas.DatabaseUser
+	if state.Users.IsNull() {
+		// On import all the users of the project are read.
+		dbUsers, err := listDatabaseUsers(ctx, conn, projectID)
+		if err != nil {
+			resp.Diagnostics.AddError("error getting database users information", err.Error())
+			return
+		}
+		users = dbUsers
+	} else {
+		current, d := newMongoDBBatchDatabaseUsers(ctx, projectID, state.Users)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var errs []error
+		users, errs = applyDatabaseUsersConcurrently(int(state.Parallelism.ValueInt64()), current, func(user *matlas.DatabaseUser) (*matlas.DatabaseUser, error) {
+			dbUser, httpResponse, err := conn.DatabaseUsers.Get(ctx, user.DatabaseName, projectID, user.Username)
+			if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+				// Deleted in the backend, the user is dropped from the state and recreated on the next apply.
+				return nil, nil
+			}
+			return dbUser, err
+		})
+		appendDatabaseUsersErrors(&resp.Diagnostics, "error getting database user information", errs)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	newState, d := newTFDatabaseUsersModel(ctx, state, users, nil)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *DatabaseUsersRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *tfDatabaseUsersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModels, stateModels []tfBatchDatabaseUserModel
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &planModels, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planByKey := make(map[string]*tfBatchDatabaseUserModel, len(planModels))
+	for i := range planModels {
+		planByKey[planModels[i].key()] = &planModels[i]
+	}
+	stateByKey := make(map[string]*tfBatchDatabaseUserModel, len(stateModels))
+	for i := range stateModels {
+		stateByKey[stateModels[i].key()] = &stateModels[i]
+	}
+
+	// Only the users added, changed or removed in the plan are sent to Atlas, the rest keep their current state.
+	var kept []tfBatchDatabaseUserModel
+	var removedModels, createdModels, updatedModels []tfBatchDatabaseUserModel
+	for i := range stateModels {
+		planModel, ok := planByKey[stateModels[i].key()]
+		switch {
+		case !ok:
+			removedModels = append(removedModels, stateModels[i])
+		case planModel.equal(&stateModels[i]):
+			kept = append(kept, stateModels[i])
+		default:
+			updatedModels = append(updatedModels, *planModel)
+		}
+	}
+	for i := range planModels {
+		if _, ok := stateByKey[planModels[i].key()]; !ok {
+			createdModels = append(createdModels, planModels[i])
+		}
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	removed, d := newMongoDBBatchDatabaseUsersFromModels(ctx, projectID, removedModels)
+	resp.Diagnostics.Append(d...)
+	created, d := newMongoDBBatchDatabaseUsersFromModels(ctx, projectID, createdModels)
+	resp.Diagnostics.Append(d...)
+	updated, d := newMongoDBBatchDatabaseUsersFromModels(ctx, projectID, updatedModels)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warnDatabaseUsersScopes(ctx, r.client, projectID, created, &resp.Diagnostics)
+	warnDatabaseUsersScopes(ctx, r.client, projectID, updated, &resp.Diagnostics)
+
+	parallelism := int(plan.Parallelism.ValueInt64())
+	conn := r.client.Atlas
+	deleted, errs := applyDatabaseUsersConcurrently(parallelism, removed, func(user *matlas.DatabaseUser) (*matlas.DatabaseUser, error) {
+		httpResponse, err := conn.DatabaseUsers.Delete(ctx, user.DatabaseName, projectID, user.Username)
+		if err != nil && (httpResponse == nil || httpResponse.StatusCode != http.StatusNotFound) {
+			return nil, err
+		}
+		return user, nil
+	})
+	appendDatabaseUsersErrors(&resp.Diagnostics, "error when destroying the database user", errs)
+
+	applied, errs := applyDatabaseUsersConcurrently(parallelism, created, func(user *matlas.DatabaseUser) (*matlas.DatabaseUser, error) {
+		dbUser, _, err := conn.DatabaseUsers.Create(ctx, projectID, user)
+		return dbUser, err
+	})
+	appendDatabaseUsersErrors(&resp.Diagnostics, "error during database user creation", errs)
+
+	updatedUsers, errs := applyDatabaseUsersConcurrently(parallelism, updated, func(user *matlas.DatabaseUser) (*matlas.DatabaseUser, error) {
+		dbUser, _, err := conn.DatabaseUsers.Update(ctx, projectID, user.Username, user)
+		return dbUser, err
+	})
+	appendDatabaseUsersErrors(&resp.Diagnostics, "error during database user update", errs)
+	applied = append(applied, updatedUsers...)
+
+	// The users whose deletion or update failed keep their previous state, so the change is retried on the next apply.
+	done := make(map[string]bool, len(deleted)+len(updatedUsers))
+	for _, user := range deleted {
+		done[databaseUserKey(user)] = true
+	}
+	for _, user := range updatedUsers {
+		done[databaseUserKey(user)] = true
+	}
+	for i := range removedModels {
+		if !done[removedModels[i].key()] {
+			kept = append(kept, removedModels[i])
+		}
+	}
+	for i := range updatedModels {
+		if !done[updatedModels[i].key()] {
+			kept = append(kept, *stateByKey[updatedModels[i].key()])
+		}
+	}
+
+	newState, d := newTFDatabaseUsersModel(ctx, plan, applied, kept)
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *DatabaseUsersRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *tfDatabaseUsersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	users, d := newMongoDBBatchDatabaseUsers(ctx, projectID, state.Users)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	_, errs := applyDatabaseUsersConcurrently(int(state.Parallelism.ValueInt64()), users, func(user *matlas.DatabaseUser) (*matlas.DatabaseUser, error) {
+		httpResponse, err := conn.DatabaseUsers.Delete(ctx, user.DatabaseName, projectID, user.Username)
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	})
+	appendDatabaseUsersErrors(&resp.Diagnostics, "error when destroying the database user", errs)
+}
+
+func (r *DatabaseUsersRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyDatabaseUsersConcurrently calls fn for every user with at most parallelism calls in flight. It returns
+// the non-nil users returned by fn and one error per failed user, both in the order of the input.
+func applyDatabaseUsersConcurrently(parallelism int, users []*matlas.DatabaseUser, fn func(*matlas.DatabaseUser) (*matlas.DatabaseUser, error)) ([]*matlas.DatabaseUser, []error) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]*matlas.DatabaseUser, len(users))
+	errs := make([]error, len(users))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i := range users {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			dbUser, err := fn(users[i])
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", databaseUserKey(users[i]), err)
+				return
+			}
+			results[i] = dbUser
+		}(i)
+	}
+	wg.Wait()
+
+	var applied []*matlas.DatabaseUser
+	var failed []error
+	for i := range users {
+		if results[i] != nil {
+			applied = append(applied, results[i])
+		}
+		if errs[i] != nil {
+			failed = append(failed, errs[i])
+		}
+	}
+
+	return applied, failed
+}
+
+// listDatabaseUsers returns all the database users of a project, reading every page.
+func listDatabaseUsers(ctx context.Context, conn *matlas.Client, projectID string) ([]*matlas.DatabaseUser, error) {
+	var users []*matlas.DatabaseUser
+	for page := 1; ; page++ {
+		dbUsers, _, err := conn.DatabaseUsers.List(ctx, projectID, &matlas.ListOptions{PageNum: page, ItemsPerPage: databaseUsersItemsPerPage})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range dbUsers {
+			users = append(users, &dbUsers[i])
+		}
+		if len(dbUsers) < databaseUsersItemsPerPage {
+			return users, nil
+		}
+	}
+}
+
+func appendDatabaseUsersErrors(diags *diag.Diagnostics, summary string, errs []error) {
+	for _, err := range errs {
+		diags.AddError(summary, err.Error())
+	}
+}
+
+// warnDatabaseUsersScopes adds a warning for every user with a scope that doesn't match a cluster, serverless
+// instance or federated database instance of the project.
+func warnDatabaseUsersScopes(ctx context.Context, client *MongoDBClient, projectID string, users []*matlas.DatabaseUser, diags *diag.Diagnostics) {
+	for _, user := range users {
+		if err := validateDatabaseUserScopes(ctx, client, projectID, user.Scopes); err != nil {
+			diags.AddWarning(errorDatabaseUserScopes, fmt.Sprintf("user %s: %s", databaseUserKey(user), err))
+		}
+	}
+}
+
+func databaseUserKey(user *matlas.DatabaseUser) string {
+	return fmt.Sprintf("%s/%s", user.DatabaseName, user.Username)
+}
+
+func (m *tfBatchDatabaseUserModel) key() string {
+	return fmt.Sprintf("%s/%s", m.AuthDatabaseName.ValueString(), m.Username.ValueString())
+}
+
+func (m *tfBatchDatabaseUserModel) equal(other *tfBatchDatabaseUserModel) bool {
+	return m.AuthDatabaseName.Equal(other.AuthDatabaseName) &&
+		m.Username.Equal(other.Username) &&
+		m.Password.Equal(other.Password) &&
+		m.X509Type.Equal(other.X509Type) &&
+		m.OIDCAuthType.Equal(other.OIDCAuthType) &&
+		m.LDAPAuthType.Equal(other.LDAPAuthType) &&
+		m.AWSIAMType.Equal(other.AWSIAMType) &&
+		m.Roles.Equal(other.Roles) &&
+		m.Labels.Equal(other.Labels) &&
+		m.Scopes.Equal(other.Scopes)
+}
+
+func newMongoDBBatchDatabaseUsers(ctx context.Context, projectID string, users types.Set) ([]*matlas.DatabaseUser, diag.Diagnostics) {
+	var usersModel []tfBatchDatabaseUserModel
+	diags := users.ElementsAs(ctx, &usersModel, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out, d := newMongoDBBatchDatabaseUsersFromModels(ctx, projectID, usersModel)
+	diags.Append(d...)
+	return out, diags
+}
+
+func newMongoDBBatchDatabaseUsersFromModels(ctx context.Context, projectID string, usersModel []tfBatchDatabaseUserModel) ([]*matlas.DatabaseUser, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := make([]*matlas.DatabaseUser, 0, len(usersModel))
+	for _, user := range usersModel {
+		dbUser, d := newMongoDBDatabaseUser(ctx, &tfDatabaseUserModel{
+			ProjectID:        types.StringValue(projectID),
+			AuthDatabaseName: user.AuthDatabaseName,
+			Username:         user.Username,
+			Password:         user.Password,
+			X509Type:         user.X509Type,
+			OIDCAuthType:     user.OIDCAuthType,
+			LDAPAuthType:     user.LDAPAuthType,
+			AWSIAMType:       user.AWSIAMType,
+			Roles:            user.Roles,
+			Labels:           user.Labels,
+			Scopes:           user.Scopes,
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		out = append(out, dbUser)
+	}
+
+	return out, diags
+}
+
+// newTFDatabaseUsersModel builds the state from the users returned by Atlas plus the kept users, which are stored
+// as they are. The passwords are not returned by the API, so they are taken from the users of the model with the
+// same auth database and username.
+func newTFDatabaseUsersModel(ctx context.Context, model *tfDatabaseUsersModel, dbUsers []*matlas.DatabaseUser, kept []tfBatchDatabaseUserModel) (*tfDatabaseUsersModel, diag.Diagnostics) {
+	passwords := map[string]types.String{}
+	if !model.Users.IsNull() && !model.Users.IsUnknown() {
+		var usersModel []*tfBatchDatabaseUserModel
+		if diags := model.Users.ElementsAs(ctx, &usersModel, false); diags.HasError() {
+			return nil, diags
+		}
+		for _, user := range usersModel {
+			passwords[user.key()] = user.Password
+		}
+	}
+
+	sort.Slice(dbUsers, func(i, j int) bool {
+		return databaseUserKey(dbUsers[i]) < databaseUserKey(dbUsers[j])
+	})
+
+	var diags diag.Diagnostics
+	usersModel := make([]tfBatchDatabaseUserModel, 0, len(dbUsers)+len(kept))
+	usersModel = append(usersModel, kept...)
+	for _, dbUser := range dbUsers {
+		userModel, d := newTFDatabaseUserModel(ctx, nil, dbUser)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		password := types.StringNull()
+		if p, ok := passwords[databaseUserKey(dbUser)]; ok {
+			password = p
+		}
+
+		usersModel = append(usersModel, tfBatchDatabaseUserModel{
+			AuthDatabaseName: userModel.AuthDatabaseName,
+			Username:         userModel.Username,
+			Password:         password,
+			X509Type:         userModel.X509Type,
+			OIDCAuthType:     userModel.OIDCAuthType,
+			LDAPAuthType:     userModel.LDAPAuthType,
+			AWSIAMType:       userModel.AWSIAMType,
+			Roles:            userModel.Roles,
+			Labels:           userModel.Labels,
+			Scopes:           userModel.Scopes,
+		})
+	}
+
+	usersSet, d := types.SetValueFrom(ctx, BatchDatabaseUserObjectType, usersModel)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &tfDatabaseUsersModel{
+		ID:          types.StringValue(model.ProjectID.ValueString()),
+		ProjectID:   model.ProjectID,
+		Users:       usersSet,
+		Parallelism: model.Parallelism,
+	}, diags
+}
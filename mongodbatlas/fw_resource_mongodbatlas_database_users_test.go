@@ -0,0 +1,157 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConfigRSDatabaseUsers_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_database_users.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		usernames    = []string{
+			acctest.RandomWithPrefix("dbUser"),
+			acctest.RandomWithPrefix("dbUser"),
+			acctest.RandomWithPrefix("dbUser"),
+		}
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasDatabaseUsersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasDatabaseUsersConfig(projectName, orgID, "read", usernames),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasDatabaseUsersExist(resourceName, 3),
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "parallelism", "2"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "3"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasDatabaseUsersConfig(projectName, orgID, "readWriteAnyDatabase", usernames[1:]),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasDatabaseUsersExist(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestBatchDatabaseUserModelEqual(t *testing.T) {
+	newModel := func(password string) *tfBatchDatabaseUserModel {
+		return &tfBatchDatabaseUserModel{
+			AuthDatabaseName: types.StringValue("admin"),
+			Username:         types.StringValue("dbUser"),
+			Password:         types.StringValue(password),
+			X509Type:         types.StringValue("NONE"),
+			OIDCAuthType:     types.StringValue("NONE"),
+			LDAPAuthType:     types.StringValue("NONE"),
+			AWSIAMType:       types.StringValue("NONE"),
+			Roles:            types.SetNull(RoleObjectType),
+			Labels:           types.SetNull(LabelObjectType),
+			Scopes:           types.SetNull(ScopeObjectType),
+		}
+	}
+
+	if key := newModel("pass").key(); key != "admin/dbUser" {
+		t.Errorf("unexpected key: %s", key)
+	}
+	if !newModel("pass").equal(newModel("pass")) {
+		t.Error("expected users with the same attributes to be equal")
+	}
+	if newModel("pass").equal(newModel("other")) {
+		t.Error("expected users with different passwords not to be equal")
+	}
+}
+
+func testAccCheckMongoDBAtlasDatabaseUsersExist(resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testMongoDBClient.(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		dbUsers, _, err := conn.DatabaseUsers.List(context.Background(), rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		found := 0
+		for i := range dbUsers {
+			if strings.HasPrefix(dbUsers[i].Username, "dbUser") {
+				found++
+			}
+		}
+		if found != count {
+			return fmt.Errorf("expected %d database users, found %d", count, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasDatabaseUsersDestroy(s *terraform.State) error {
+	conn := testMongoDBClient.(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_database_users" {
+			continue
+		}
+
+		dbUsers, _, err := conn.DatabaseUsers.List(context.Background(), rs.Primary.ID, nil)
+		if err == nil && len(dbUsers) > 0 {
+			return fmt.Errorf("database users of project (%s) still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccMongoDBAtlasDatabaseUsersConfig(projectName, orgID, roleName string, usernames []string) string {
+	var users string
+	for _, username := range usernames {
+		users += fmt.Sprintf(`
+			users {
+				username           = %q
+				password           = "test-acc-password"
+				auth_database_name = "admin"
+
+				roles {
+					role_name     = %q
+					database_name = "admin"
+				}
+			}
+		`, username, roleName)
+	}
+
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %q
+			org_id = %q
+		}
+
+		resource "mongodbatlas_database_users" "test" {
+			project_id  = mongodbatlas_project.test.id
+			parallelism = 2
+
+			%s
+		}
+	`, projectName, orgID, users)
+}
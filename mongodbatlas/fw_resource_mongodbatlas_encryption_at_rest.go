@@ -33,10 +33,25 @@ const (
 	errorReadEncryptionAtRest    = "error getting Encryption At Rest: %s"
 	errorDeleteEncryptionAtRest  = "error deleting Encryption At Rest: (%s): %s"
 	errorUpdateEncryptionAtRest  = "error updating Encryption At Rest: %s"
+	errorEncryptionAtRestStatus  = "error getting the key status of Encryption At Rest"
+	encryptionAtRestPath         = "api/atlas/v2/groups/%s/encryptionAtRest"
 )
 
+// encryptionAtRestKeyStatus holds the fields of a key configuration that the matlas client doesn't model.
+type encryptionAtRestKeyStatus struct {
+	Valid                    *bool `json:"valid,omitempty"`
+	RequirePrivateNetworking *bool `json:"requirePrivateNetworking,omitempty"`
+}
+
+type encryptionAtRestStatus struct {
+	AwsKms         encryptionAtRestKeyStatus `json:"awsKms"`
+	AzureKeyVault  encryptionAtRestKeyStatus `json:"azureKeyVault"`
+	GoogleCloudKms encryptionAtRestKeyStatus `json:"googleCloudKms"`
+}
+
 var _ resource.ResourceWithConfigure = &EncryptionAtRestRS{}
 var _ resource.ResourceWithImportState = &EncryptionAtRestRS{}
+var _ resource.ResourceWithValidateConfig = &EncryptionAtRestRS{}
 
 func NewEncryptionAtRestRS() resource.Resource {
 	return &EncryptionAtRestRS{
@@ -59,12 +74,14 @@ type tfEncryptionAtRestRSModel struct {
 }
 
 type tfAwsKmsConfigModel struct {
-	AccessKeyID         types.String `tfsdk:"access_key_id"`
-	SecretAccessKey     types.String `tfsdk:"secret_access_key"`
-	CustomerMasterKeyID types.String `tfsdk:"customer_master_key_id"`
-	Region              types.String `tfsdk:"region"`
-	RoleID              types.String `tfsdk:"role_id"`
-	Enabled             types.Bool   `tfsdk:"enabled"`
+	AccessKeyID              types.String `tfsdk:"access_key_id"`
+	SecretAccessKey          types.String `tfsdk:"secret_access_key"`
+	CustomerMasterKeyID      types.String `tfsdk:"customer_master_key_id"`
+	Region                   types.String `tfsdk:"region"`
+	RoleID                   types.String `tfsdk:"role_id"`
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	Valid                    types.Bool   `tfsdk:"valid"`
+	RequirePrivateNetworking types.Bool   `tfsdk:"require_private_networking"`
 }
 type tfAzureKeyVaultConfigModel struct {
 	ClientID          types.String `tfsdk:"client_id"`
@@ -76,11 +93,13 @@ type tfAzureKeyVaultConfigModel struct {
 	Secret            types.String `tfsdk:"secret"`
 	TenantID          types.String `tfsdk:"tenant_id"`
 	Enabled           types.Bool   `tfsdk:"enabled"`
+	Valid             types.Bool   `tfsdk:"valid"`
 }
 type tfGcpKmsConfigModel struct {
 	ServiceAccountKey    types.String `tfsdk:"service_account_key"`
 	KeyVersionResourceID types.String `tfsdk:"key_version_resource_id"`
 	Enabled              types.Bool   `tfsdk:"enabled"`
+	Valid                types.Bool   `tfsdk:"valid"`
 }
 
 func (r *EncryptionAtRestRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -129,6 +148,13 @@ func (r *EncryptionAtRestRS) Schema(ctx context.Context, req resource.SchemaRequ
 						"role_id": schema.StringAttribute{
 							Optional: true,
 						},
+						"valid": schema.BoolAttribute{
+							Computed: true,
+						},
+						"require_private_networking": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+						},
 					},
 					Validators: []validator.Object{validators.AwsKmsConfig()},
 				},
@@ -173,6 +199,9 @@ func (r *EncryptionAtRestRS) Schema(ctx context.Context, req resource.SchemaRequ
 							Optional:  true,
 							Sensitive: true,
 						},
+						"valid": schema.BoolAttribute{
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -195,6 +224,9 @@ func (r *EncryptionAtRestRS) Schema(ctx context.Context, req resource.SchemaRequ
 							Optional:  true,
 							Sensitive: true,
 						},
+						"valid": schema.BoolAttribute{
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -243,8 +275,16 @@ func (r *EncryptionAtRestRS) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if err = updateAwsKmsRequirePrivateNetworking(ctx, conn, projectID, encryptionAtRestReq.AwsKms, encryptionAtRestPlan.AwsKmsConfig, nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorCreateEncryptionAtRest, projectID), err.Error())
+		return
+	}
+
 	encryptionAtRestPlanNew := newTFEncryptionAtRestRSModel(ctx, projectID, encryptionResp.(*matlas.EncryptionAtRest), encryptionAtRestPlan)
 	resetDefaultsFromConfigOrState(ctx, encryptionAtRestPlan, encryptionAtRestPlanNew, encryptionAtRestConfig)
+	if err = setEncryptionAtRestKeyStatus(ctx, conn, projectID, encryptionAtRestPlanNew); err != nil {
+		resp.Diagnostics.AddWarning(errorEncryptionAtRestStatus, err.Error())
+	}
 
 	// set state to fully populated data
 	diags := resp.State.Set(ctx, encryptionAtRestPlanNew)
@@ -302,6 +342,9 @@ func (r *EncryptionAtRestRS) Read(ctx context.Context, req resource.ReadRequest,
 	if !isImport {
 		resetDefaultsFromConfigOrState(ctx, &encryptionAtRestState, encryptionAtRestStateNew, nil)
 	}
+	if err = setEncryptionAtRestKeyStatus(ctx, conn, projectID, encryptionAtRestStateNew); err != nil {
+		resp.Diagnostics.AddWarning(errorEncryptionAtRestStatus, err.Error())
+	}
 
 	// save read data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &encryptionAtRestStateNew)...)
@@ -360,8 +403,16 @@ func (r *EncryptionAtRestRS) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if err = updateAwsKmsRequirePrivateNetworking(ctx, conn, projectID, atlasEncryptionAtRest.AwsKms, encryptionAtRestPlan.AwsKmsConfig, encryptionAtRestState.AwsKmsConfig); err != nil {
+		resp.Diagnostics.AddError("error updating encryption at rest", fmt.Sprintf(errorUpdateEncryptionAtRest, err.Error()))
+		return
+	}
+
 	encryptionAtRestStateNew := newTFEncryptionAtRestRSModel(ctx, projectID, encryptionResp, encryptionAtRestPlan)
 	resetDefaultsFromConfigOrState(ctx, encryptionAtRestState, encryptionAtRestStateNew, encryptionAtRestConfig)
+	if err = setEncryptionAtRestKeyStatus(ctx, conn, projectID, encryptionAtRestStateNew); err != nil {
+		resp.Diagnostics.AddWarning(errorEncryptionAtRestStatus, err.Error())
+	}
 
 	// save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &encryptionAtRestStateNew)...)
@@ -391,15 +442,53 @@ func (r *EncryptionAtRestRS) ImportState(ctx context.Context, req resource.Impor
 }
 
 func hasGcpKmsConfigChanged(gcpKmsConfigsPlan, gcpKmsConfigsState []tfGcpKmsConfigModel) bool {
-	return !reflect.DeepEqual(gcpKmsConfigsPlan, gcpKmsConfigsState)
+	return !reflect.DeepEqual(gcpKmsConfigArguments(gcpKmsConfigsPlan), gcpKmsConfigArguments(gcpKmsConfigsState))
 }
 
 func hasAzureKeyVaultConfigChanged(azureKeyVaultConfigPlan, azureKeyVaultConfigState []tfAzureKeyVaultConfigModel) bool {
-	return !reflect.DeepEqual(azureKeyVaultConfigPlan, azureKeyVaultConfigState)
+	return !reflect.DeepEqual(azureKeyVaultConfigArguments(azureKeyVaultConfigPlan), azureKeyVaultConfigArguments(azureKeyVaultConfigState))
 }
 
 func hasAwsKmsConfigChanged(awsKmsConfigPlan, awsKmsConfigState []tfAwsKmsConfigModel) bool {
-	return !reflect.DeepEqual(awsKmsConfigPlan, awsKmsConfigState)
+	return !reflect.DeepEqual(awsKmsConfigArguments(awsKmsConfigPlan), awsKmsConfigArguments(awsKmsConfigState))
+}
+
+// The *ConfigArguments functions drop the computed attributes, which are unknown in the plan, so only the
+// arguments are compared.
+func gcpKmsConfigArguments(configs []tfGcpKmsConfigModel) []tfGcpKmsConfigModel {
+	if configs == nil {
+		return nil
+	}
+	out := make([]tfGcpKmsConfigModel, len(configs))
+	for i, config := range configs {
+		config.Valid = types.Bool{}
+		out[i] = config
+	}
+	return out
+}
+
+func azureKeyVaultConfigArguments(configs []tfAzureKeyVaultConfigModel) []tfAzureKeyVaultConfigModel {
+	if configs == nil {
+		return nil
+	}
+	out := make([]tfAzureKeyVaultConfigModel, len(configs))
+	for i, config := range configs {
+		config.Valid = types.Bool{}
+		out[i] = config
+	}
+	return out
+}
+
+func awsKmsConfigArguments(configs []tfAwsKmsConfigModel) []tfAwsKmsConfigModel {
+	if configs == nil {
+		return nil
+	}
+	out := make([]tfAwsKmsConfigModel, len(configs))
+	for i, config := range configs {
+		config.Valid, config.RequirePrivateNetworking = types.Bool{}, types.Bool{}
+		out[i] = config
+	}
+	return out
 }
 
 // resetDefaultsFromConfigOrState resets certain values that are not returned by the Atlas APIs from the Config
@@ -485,6 +574,9 @@ func newTFAwsKmsConfig(ctx context.Context, awsKms *matlas.AwsKms, currStateSlic
 	newState.AccessKeyID = conversion.StringNullIfEmpty(awsKms.AccessKeyID)
 	newState.SecretAccessKey = conversion.StringNullIfEmpty(awsKms.SecretAccessKey)
 	newState.RoleID = conversion.StringNullIfEmpty(awsKms.RoleID)
+	if len(currStateSlice) > 0 {
+		newState.RequirePrivateNetworking = currStateSlice[0].RequirePrivateNetworking
+	}
 
 	return []tfAwsKmsConfigModel{newState}
 }
@@ -570,3 +662,90 @@ func newAtlasAzureKeyVault(tfAzKeyVaultConfigSlice []tfAzureKeyVaultConfigModel)
 		TenantID:          v.TenantID.ValueString(),
 	}
 }
+
+func getEncryptionAtRestStatus(ctx context.Context, conn *matlas.Client, projectID string) (*encryptionAtRestStatus, error) {
+	root := new(encryptionAtRestStatus)
+	if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodGet, fmt.Sprintf(encryptionAtRestPath, projectID), atlasAPIV2Version20230101, nil, root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// updateAwsKmsRequirePrivateNetworking sends require_private_networking, which the matlas client doesn't support,
+// when it is set in the plan and differs from the state.
+func updateAwsKmsRequirePrivateNetworking(ctx context.Context, conn *matlas.Client, projectID string, awsKms matlas.AwsKms, plan, state []tfAwsKmsConfigModel) error {
+	if len(plan) == 0 || plan[0].RequirePrivateNetworking.IsNull() || plan[0].RequirePrivateNetworking.IsUnknown() {
+		return nil
+	}
+	if len(state) > 0 && plan[0].RequirePrivateNetworking.Equal(state[0].RequirePrivateNetworking) {
+		return nil
+	}
+
+	body := map[string]interface{}{
+		"awsKms": struct {
+			matlas.AwsKms
+			RequirePrivateNetworking bool `json:"requirePrivateNetworking"`
+		}{awsKms, plan[0].RequirePrivateNetworking.ValueBool()},
+	}
+	_, err := doAtlasAPIV2Request(ctx, conn, http.MethodPatch, fmt.Sprintf(encryptionAtRestPath, projectID), atlasAPIV2Version20230101, body, nil)
+	return err
+}
+
+// setEncryptionAtRestKeyStatus sets the computed attributes that report whether each key is valid. The status is only
+// informational, so when it can't be read the attributes are left null and the error is returned for a warning.
+func setEncryptionAtRestKeyStatus(ctx context.Context, conn *matlas.Client, projectID string, earRSNew *tfEncryptionAtRestRSModel) error {
+	status, err := getEncryptionAtRestStatus(ctx, conn, projectID)
+	if err != nil {
+		status = new(encryptionAtRestStatus)
+	}
+
+	if len(earRSNew.AwsKmsConfig) > 0 {
+		config := &earRSNew.AwsKmsConfig[0]
+		config.Valid = types.BoolPointerValue(status.AwsKms.Valid)
+		if status.AwsKms.RequirePrivateNetworking != nil {
+			config.RequirePrivateNetworking = types.BoolPointerValue(status.AwsKms.RequirePrivateNetworking)
+		} else if config.RequirePrivateNetworking.IsNull() || config.RequirePrivateNetworking.IsUnknown() {
+			config.RequirePrivateNetworking = types.BoolValue(false)
+		}
+	}
+
+	if len(earRSNew.AzureKeyVaultConfig) > 0 {
+		config := &earRSNew.AzureKeyVaultConfig[0]
+		config.Valid = types.BoolPointerValue(status.AzureKeyVault.Valid)
+	}
+
+	if len(earRSNew.GoogleCloudKmsConfig) > 0 {
+		config := &earRSNew.GoogleCloudKmsConfig[0]
+		config.Valid = types.BoolPointerValue(status.GoogleCloudKms.Valid)
+	}
+
+	return err
+}
+
+// ValidateConfig checks that at most one cloud provider configuration is enabled, as Atlas encrypts the project
+// with a single key management service at a time.
+func (r *EncryptionAtRestRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config tfEncryptionAtRestRSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var enabled []string
+	if len(config.AwsKmsConfig) > 0 && config.AwsKmsConfig[0].Enabled.ValueBool() {
+		enabled = append(enabled, "aws_kms_config")
+	}
+	if len(config.AzureKeyVaultConfig) > 0 && config.AzureKeyVaultConfig[0].Enabled.ValueBool() {
+		enabled = append(enabled, "azure_key_vault_config")
+	}
+	if len(config.GoogleCloudKmsConfig) > 0 && config.GoogleCloudKmsConfig[0].Enabled.ValueBool() {
+		enabled = append(enabled, "google_cloud_kms_config")
+	}
+
+	if len(enabled) > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root(enabled[1]),
+			"invalid encryption at rest configuration",
+			fmt.Sprintf("only one cloud provider configuration can be enabled at a time, found %v", enabled))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	matlas "go.mongodb.org/atlas/mongodbatlas"
@@ -134,6 +135,7 @@ func TestAccAdvRSEncryptionAtRest_basicAWS(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "aws_kms_config.0.enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "aws_kms_config.0.region", awsKms.Region),
 					resource.TestCheckResourceAttr(resourceName, "aws_kms_config.0.role_id", awsKms.RoleID),
+					resource.TestCheckResourceAttr(resourceName, "aws_kms_config.0.valid", "true"),
 				),
 			},
 			{
@@ -279,6 +281,41 @@ func TestAccAdvRSEncryptionAtRest_basicGCP(t *testing.T) {
 	})
 }
 
+func TestAccAdvRSEncryptionAtRest_multipleEnabled(t *testing.T) {
+	var (
+		projectID = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "mongodbatlas_encryption_at_rest" "test" {
+						project_id = %[1]q
+
+						aws_kms_config {
+							enabled                = true
+							customer_master_key_id = "key-id"
+							region                 = "US_EAST_1"
+							role_id                = "role-id"
+						}
+
+						google_cloud_kms_config {
+							enabled                 = true
+							service_account_key     = "service-account-key"
+							key_version_resource_id = "key-version"
+						}
+					}
+				`, projectID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only one cloud provider configuration can be enabled at a time"),
+			},
+		},
+	})
+}
+
 func TestAccAdvRSEncryptionAtRestWithRole_basicAWS(t *testing.T) {
 	SkipTest(t) // For now it will skipped because of aws errors reasons, already made another test using terratest.
 	SkipTestExtCred(t)
@@ -40,10 +40,16 @@ const (
 	projectDependentsStateIdle     = "IDLE"
 	projectDependentsStateDeleting = "DELETING"
 	projectDependentsStateRetry    = "RETRY"
+	errorProjectNotEmpty           = "project (%s) still contains the clusters %s, Atlas rejects deleting it unless they're deleted first or `force_destroy` is true"
 )
 
+var privateEndpointProviders = []string{"AWS", "AZURE", "GCP"}
+
+var networkPeeringProviders = []string{"AWS", "AZURE", "GCP"}
+
 var _ resource.ResourceWithConfigure = &ProjectRS{}
 var _ resource.ResourceWithImportState = &ProjectRS{}
+var _ resource.ResourceWithModifyPlan = &ProjectRS{}
 
 func NewProjectRS() resource.Resource {
 	return &ProjectRS{
@@ -74,6 +80,7 @@ type tfProjectRSModel struct {
 	IsExtendedStorageSizesEnabled               types.Bool   `tfsdk:"is_extended_storage_sizes_enabled"`
 	IsCollectDatabaseSpecificsStatisticsEnabled types.Bool   `tfsdk:"is_collect_database_specifics_statistics_enabled"`
 	WithDefaultAlertsSettings                   types.Bool   `tfsdk:"with_default_alerts_settings"`
+	ForceDestroy                                types.Bool   `tfsdk:"force_destroy"`
 }
 
 type tfTeamModel struct {
@@ -191,6 +198,11 @@ func (r *ProjectRS) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"region_usage_restrictions": schema.StringAttribute{
 				Optional: true,
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"teams": schema.SetNestedBlock{
@@ -415,6 +427,8 @@ func (r *ProjectRS) Read(ctx context.Context, req resource.ReadRequest, resp *re
 		return
 	}
 
+	warnRemovedProjectTeams(ctx, &projectState, atlasTeams, resp)
+
 	atlasLimits = filterUserDefinedLimits(atlasLimits, limits)
 	projectStateNew := newTFProjectResourceModel(ctx, projectRes, atlasTeams, atlasProjectSettings, atlasLimits)
 	updatePlanFromConfig(projectStateNew, &projectState)
@@ -450,6 +464,12 @@ func (r *ProjectRS) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	err = updateProjectOwner(ctx, connV2, &projectState, &projectPlan)
+	if err != nil {
+		resp.Diagnostics.AddError("error in project owner update", fmt.Sprintf(errorProjectUpdate, projectID, err.Error()))
+		return
+	}
+
 	err = updateProjectTeams(ctx, conn, &projectState, &projectPlan)
 	if err != nil {
 		resp.Diagnostics.AddError("error in project teams update", fmt.Sprintf(errorProjectUpdate, projectID, err.Error()))
@@ -504,6 +524,13 @@ func (r *ProjectRS) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	}
 
 	projectID := project.ID.ValueString()
+	if project.ForceDestroy.ValueBool() {
+		if err := deleteProjectDependents(ctx, r.client.Atlas, projectID); err != nil {
+			resp.Diagnostics.AddError("error when destroying resource", fmt.Sprintf(errorProjectDelete, projectID, err.Error()))
+			return
+		}
+	}
+
 	err := deleteProject(ctx, r.client.Atlas, projectID)
 
 	if err != nil {
@@ -512,8 +539,38 @@ func (r *ProjectRS) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	}
 }
 
+// ModifyPlan checks before destroying a project without force_destroy that it has no clusters left. Clusters managed
+// in the same configuration are still listed at plan time even if they're destroyed first, so this is only a warning.
+func (r *ProjectRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var project tfProjectRSModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &project)...)
+	if resp.Diagnostics.HasError() || project.ForceDestroy.ValueBool() {
+		return
+	}
+
+	if err := checkProjectHasNoActiveClusters(ctx, r.client.Atlas, project.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddWarning("project may not be deleted", err.Error())
+	}
+}
+
 func (r *ProjectRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	names, ok := splitImportNames(req.ID, 2)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	projectID, err := resolveProjectID(ctx, r.client.Atlas, names[0], names[1])
+	if err != nil {
+		resp.Diagnostics.AddError("error when importing project", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectID)...)
 }
 
 func updatePlanFromConfig(projectPlanNewPtr, projectPlan *tfProjectRSModel) {
@@ -521,6 +578,9 @@ func updatePlanFromConfig(projectPlanNewPtr, projectPlan *tfProjectRSModel) {
 	// https://discuss.hashicorp.com/t/boolean-optional-default-value-migration-to-framework/55932
 	projectPlanNewPtr.WithDefaultAlertsSettings = projectPlan.WithDefaultAlertsSettings
 	projectPlanNewPtr.ProjectOwnerID = projectPlan.ProjectOwnerID
+	if !projectPlan.ForceDestroy.IsNull() {
+		projectPlanNewPtr.ForceDestroy = projectPlan.ForceDestroy
+	}
 }
 
 func filterUserDefinedLimits(allAtlasLimits []admin.DataFederationLimit, tflimits []tfLimitModel) []admin.DataFederationLimit {
@@ -568,6 +628,7 @@ func newTFProjectResourceModel(ctx context.Context, projectRes *matlas.Project,
 		ClusterCount:              types.Int64Value(int64(projectRes.ClusterCount)),
 		Created:                   types.StringValue(projectRes.Created),
 		WithDefaultAlertsSettings: types.BoolPointerValue(projectRes.WithDefaultAlertsSettings),
+		ForceDestroy:              types.BoolValue(false),
 		Teams:                     newTFTeamsResourceModel(ctx, teams),
 		Limits:                    newTFLimitsResourceModel(ctx, limits),
 	}
@@ -739,8 +800,13 @@ func updateProjectTeams(ctx context.Context, conn *matlas.Client, projectState,
 	// removing teams from the project
 	for _, team := range removedTeams {
 		teamID := team.TeamID.ValueString()
-		_, err := conn.Teams.RemoveTeamFromProject(ctx, projectID, team.TeamID.ValueString())
+		atlasResp, err := conn.Teams.RemoveTeamFromProject(ctx, projectID, team.TeamID.ValueString())
 		if err != nil {
+			// the team was deleted outside of Terraform so it is no longer assigned to the project
+			if atlasResp != nil && atlasResp.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] team(%s) not found when removing it from the project(%s), skipping", teamID, projectID)
+				continue
+			}
 			var target *matlas.ErrorResponse
 			if errors.As(err, &target) && target.ErrorCode != "USER_UNAUTHORIZED" {
 				return fmt.Errorf("error removing team(%s) from the project(%s): %s", teamID, projectID, err)
@@ -771,6 +837,51 @@ func updateProjectTeams(ctx context.Context, conn *matlas.Client, projectState,
 	return nil
 }
 
+// warnRemovedProjectTeams adds a warning for every team present in the state that is no longer assigned to the project,
+// e.g. because it was deleted from the organization outside of Terraform. Those teams are dropped from the state on refresh.
+func warnRemovedProjectTeams(ctx context.Context, projectState *tfProjectRSModel, atlasTeams *matlas.TeamsAssigned, resp *resource.ReadResponse) {
+	var stateTeams []tfTeamModel
+	_ = projectState.Teams.ElementsAs(ctx, &stateTeams, false)
+
+	assigned := make(map[string]bool, len(atlasTeams.Results))
+	for _, atlasTeam := range atlasTeams.Results {
+		assigned[atlasTeam.TeamID] = true
+	}
+
+	for _, team := range stateTeams {
+		teamID := team.TeamID.ValueString()
+		if !assigned[teamID] {
+			resp.Diagnostics.AddWarning("team no longer assigned to the project",
+				fmt.Sprintf("team (%s) is not assigned to project (%s) anymore, it may have been deleted outside of Terraform. It has been removed from the state.",
+					teamID, projectState.ID.ValueString()))
+		}
+	}
+}
+
+func updateProjectOwner(ctx context.Context, connV2 *admin.APIClient, projectState, projectPlan *tfProjectRSModel) error {
+	if projectPlan.ProjectOwnerID.IsNull() || projectPlan.ProjectOwnerID.Equal(projectState.ProjectOwnerID) {
+		return nil
+	}
+
+	projectID := projectState.ID.ValueString()
+	ownerID := projectPlan.ProjectOwnerID.ValueString()
+
+	user, _, err := connV2.MongoDBCloudUsersApi.GetUser(ctx, ownerID).Execute()
+	if err != nil {
+		return fmt.Errorf("error getting project owner user (%s): %s", ownerID, err)
+	}
+
+	invitation := &admin.GroupInvitationRequest{
+		Roles:    []string{"GROUP_OWNER"},
+		Username: admin.PtrString(user.Username),
+	}
+	if _, _, err := connV2.ProjectsApi.AddUserToProject(ctx, projectID, invitation).Execute(); err != nil {
+		return fmt.Errorf("error assigning user (%s) as owner of the project(%s): %s", ownerID, projectID, err)
+	}
+
+	return nil
+}
+
 func hasTeamsChanged(planTeams, stateTeams []tfTeamModel) bool {
 	sort.Slice(planTeams, func(i, j int) bool {
 		return planTeams[i].TeamID.ValueString() < planTeams[j].TeamID.ValueString()
@@ -832,6 +943,172 @@ func deleteProject(ctx context.Context, conn *matlas.Client, projectID string) e
 	return err
 }
 
+// checkProjectHasNoActiveClusters fails fast when the project still has clusters that aren't being deleted,
+// which Atlas would reject anyway once the project delete is requested.
+func checkProjectHasNoActiveClusters(ctx context.Context, conn *matlas.Client, projectID string) error {
+	clusters, _, err := conn.AdvancedClusters.List(ctx, projectID, nil)
+	if err != nil {
+		return fmt.Errorf("error listing the clusters of project (%s): %s", projectID, err)
+	}
+
+	var activeClusters []string
+	for i := range clusters.Results {
+		if clusters.Results[i].StateName != projectDependentsStateDeleting {
+			activeClusters = append(activeClusters, clusters.Results[i].Name)
+		}
+	}
+
+	if len(activeClusters) > 0 {
+		return fmt.Errorf(errorProjectNotEmpty, projectID, activeClusters)
+	}
+
+	return nil
+}
+
+// deleteProjectDependents deletes the clusters, serverless instances, private endpoints and network peering
+// connections of a project and waits until they are gone, so the project itself can be deleted.
+func deleteProjectDependents(ctx context.Context, conn *matlas.Client, projectID string) error {
+	clusters, _, err := conn.AdvancedClusters.List(ctx, projectID, nil)
+	if err != nil {
+		return fmt.Errorf("error listing clusters: %s", err)
+	}
+	for i := range clusters.Results {
+		cluster := clusters.Results[i]
+		if cluster.StateName == projectDependentsStateDeleting {
+			continue
+		}
+		if cluster.TerminationProtectionEnabled != nil && *cluster.TerminationProtectionEnabled {
+			return fmt.Errorf(errorTerminationProtectionEnabled, "MongoDB Cluster", cluster.Name)
+		}
+		tflog.Info(ctx, fmt.Sprintf("force_destroy: deleting cluster %s of project %s", cluster.Name, projectID))
+		if _, err := conn.AdvancedClusters.Delete(ctx, projectID, cluster.Name, nil); err != nil {
+			return fmt.Errorf("error deleting cluster %s: %s", cluster.Name, err)
+		}
+	}
+
+	serverlessInstances, _, err := conn.ServerlessInstances.List(ctx, projectID, nil)
+	if err != nil {
+		return fmt.Errorf("error listing serverless instances: %s", err)
+	}
+	for _, instance := range serverlessInstances.Results {
+		if instance.StateName == projectDependentsStateDeleting {
+			continue
+		}
+		if instance.TerminationProtectionEnabled != nil && *instance.TerminationProtectionEnabled {
+			return fmt.Errorf(errorTerminationProtectionEnabled, "MongoDB Serverless Instance", instance.Name)
+		}
+		tflog.Info(ctx, fmt.Sprintf("force_destroy: deleting serverless instance %s of project %s", instance.Name, projectID))
+		if _, err := conn.ServerlessInstances.Delete(ctx, projectID, instance.Name); err != nil {
+			return fmt.Errorf("error deleting serverless instance %s: %s", instance.Name, err)
+		}
+	}
+
+	for _, providerName := range privateEndpointProviders {
+		privateEndpoints, _, err := conn.PrivateEndpoints.List(ctx, projectID, providerName, nil)
+		if err != nil {
+			return fmt.Errorf("error listing %s private endpoints: %s", providerName, err)
+		}
+		for i := range privateEndpoints {
+			privateEndpoint := privateEndpoints[i]
+			var endpointIDs []string
+			endpointIDs = append(endpointIDs, privateEndpoint.InterfaceEndpoints...)
+			endpointIDs = append(endpointIDs, privateEndpoint.PrivateEndpoints...)
+			endpointIDs = append(endpointIDs, privateEndpoint.EndpointGroupNames...)
+			for _, endpointID := range endpointIDs {
+				tflog.Info(ctx, fmt.Sprintf("force_destroy: deleting %s private endpoint %s of project %s", providerName, endpointID, projectID))
+				if _, err := conn.PrivateEndpoints.DeleteOnePrivateEndpoint(ctx, projectID, providerName, privateEndpoint.ID, endpointID); err != nil {
+					return fmt.Errorf("error deleting private endpoint %s: %s", endpointID, err)
+				}
+			}
+			tflog.Info(ctx, fmt.Sprintf("force_destroy: deleting %s private endpoint service %s of project %s", providerName, privateEndpoint.ID, projectID))
+			// the service can only be deleted once its endpoints are gone
+			err = retry.RetryContext(ctx, 20*time.Minute, func() *retry.RetryError {
+				if _, err := conn.PrivateEndpoints.Delete(ctx, projectID, providerName, privateEndpoint.ID); err != nil {
+					return retry.RetryableError(err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting private endpoint service %s: %s", privateEndpoint.ID, err)
+			}
+		}
+	}
+
+	for _, providerName := range networkPeeringProviders {
+		peers, _, err := conn.Peers.List(ctx, projectID, &matlas.ContainersListOptions{ProviderName: providerName})
+		if err != nil {
+			return fmt.Errorf("error listing %s network peering connections: %s", providerName, err)
+		}
+		for i := range peers {
+			tflog.Info(ctx, fmt.Sprintf("force_destroy: deleting %s network peering connection %s of project %s", providerName, peers[i].ID, projectID))
+			if _, err := conn.Peers.Delete(ctx, projectID, peers[i].ID); err != nil {
+				return fmt.Errorf("error deleting network peering connection %s: %s", peers[i].ID, err)
+			}
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{projectDependentsStateDeleting},
+		Target:     []string{projectDependentsStateIdle},
+		Refresh:    resourceProjectDependentsDeletedRefreshFunc(ctx, projectID, conn),
+		Timeout:    3 * time.Hour,
+		MinTimeout: 30 * time.Second,
+		Delay:      0,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// resourceProjectDependentsDeletedRefreshFunc reports the project dependents as deleting until no cluster,
+// serverless instance, private endpoint service or network peering connection is left.
+func resourceProjectDependentsDeletedRefreshFunc(ctx context.Context, projectID string, client *matlas.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		clusters, _, err := client.AdvancedClusters.List(ctx, projectID, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(clusters.Results) > 0 {
+			tflog.Info(ctx, fmt.Sprintf("force_destroy: waiting for %d clusters of project %s to be deleted", len(clusters.Results), projectID))
+			return clusters, projectDependentsStateDeleting, nil
+		}
+
+		serverlessInstances, _, err := client.ServerlessInstances.List(ctx, projectID, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(serverlessInstances.Results) > 0 {
+			tflog.Info(ctx, fmt.Sprintf("force_destroy: waiting for %d serverless instances of project %s to be deleted", len(serverlessInstances.Results), projectID))
+			return serverlessInstances, projectDependentsStateDeleting, nil
+		}
+
+		for _, providerName := range privateEndpointProviders {
+			privateEndpoints, _, err := client.PrivateEndpoints.List(ctx, projectID, providerName, nil)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(privateEndpoints) > 0 {
+				tflog.Info(ctx, fmt.Sprintf("force_destroy: waiting for %d %s private endpoint services of project %s to be deleted", len(privateEndpoints), providerName, projectID))
+				return privateEndpoints, projectDependentsStateDeleting, nil
+			}
+		}
+
+		for _, providerName := range networkPeeringProviders {
+			peers, _, err := client.Peers.List(ctx, projectID, &matlas.ContainersListOptions{ProviderName: providerName})
+			if err != nil {
+				return nil, "", err
+			}
+			if len(peers) > 0 {
+				tflog.Info(ctx, fmt.Sprintf("force_destroy: waiting for %d %s network peering connections of project %s to be deleted", len(peers), providerName, projectID))
+				return peers, projectDependentsStateDeleting, nil
+			}
+		}
+
+		return "", projectDependentsStateIdle, nil
+	}
+}
+
 /*
 resourceProjectDependentsDeletingRefreshFunc assumes the project CRUD outcome will be the same for any non-zero number of dependents
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -30,6 +31,7 @@ const (
 	projectIPAccessListMinTimeout  = 2 * time.Second
 	projectIPAccessListDelay       = 4 * time.Second
 	projectIPAccessListRetry       = 2 * time.Minute
+	projectIPAccessListStatusPath  = "api/atlas/v1.0/groups/%s/accessList/%s/status"
 )
 
 type tfProjectIPAccessListModel struct {
@@ -39,9 +41,15 @@ type tfProjectIPAccessListModel struct {
 	IPAddress        types.String   `tfsdk:"ip_address"`
 	AWSSecurityGroup types.String   `tfsdk:"aws_security_group"`
 	Comment          types.String   `tfsdk:"comment"`
+	WaitForActive    types.Bool     `tfsdk:"wait_for_active"`
 	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
+// projectIPAccessListStatus is the propagation status of an access list entry to the clusters of the project.
+type projectIPAccessListStatus struct {
+	Status string `json:"STATUS"`
+}
+
 type ProjectIPAccessListRS struct {
 	RSCommon
 }
@@ -117,6 +125,9 @@ func (r *ProjectIPAccessListRS) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"wait_for_active": schema.BoolAttribute{
+				Optional: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -143,6 +154,13 @@ func (r *ProjectIPAccessListRS) Create(ctx context.Context, req resource.CreateR
 
 	conn := r.client.Atlas
 	projectID := projectIPAccessListModel.ProjectID.ValueString()
+	accessListEntry := projectIPAccessListModel.IPAddress.ValueString()
+	if projectIPAccessListModel.CIDRBlock.ValueString() != "" {
+		accessListEntry = projectIPAccessListModel.CIDRBlock.ValueString()
+	} else if projectIPAccessListModel.AWSSecurityGroup.ValueString() != "" {
+		accessListEntry = projectIPAccessListModel.AWSSecurityGroup.ValueString()
+	}
+
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"created", "failed"},
@@ -157,13 +175,6 @@ func (r *ProjectIPAccessListRS) Create(ctx context.Context, req resource.CreateR
 				return nil, "failed", fmt.Errorf(errorAccessListCreate, err)
 			}
 
-			accessListEntry := projectIPAccessListModel.IPAddress.ValueString()
-			if projectIPAccessListModel.CIDRBlock.ValueString() != "" {
-				accessListEntry = projectIPAccessListModel.CIDRBlock.ValueString()
-			} else if projectIPAccessListModel.AWSSecurityGroup.ValueString() != "" {
-				accessListEntry = projectIPAccessListModel.AWSSecurityGroup.ValueString()
-			}
-
 			entry, exists, err := isEntryInProjectAccessList(ctx, conn, projectID, accessListEntry)
 			if err != nil {
 				if strings.Contains(err.Error(), "500") {
@@ -198,6 +209,13 @@ func (r *ProjectIPAccessListRS) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	if projectIPAccessListModel.WaitForActive.ValueBool() {
+		if err := waitForProjectIPAccessListActive(ctx, conn, projectID, accessListEntry); err != nil {
+			resp.Diagnostics.AddError("error while waiting for the access list entry to be active", err.Error())
+			return
+		}
+	}
+
 	projectIPAccessListNewModel := newTFProjectIPAccessListModel(projectIPAccessListModel, entry)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &projectIPAccessListNewModel)...)
 	if resp.Diagnostics.HasError() {
@@ -225,6 +243,7 @@ func newTFProjectIPAccessListModel(projectIPAccessListModel *tfProjectIPAccessLi
 		IPAddress:        types.StringValue(projectIPAccessList.IPAddress),
 		AWSSecurityGroup: types.StringValue(projectIPAccessList.AwsSecurityGroup),
 		Comment:          types.StringValue(projectIPAccessList.Comment),
+		WaitForActive:    projectIPAccessListModel.WaitForActive,
 		Timeouts:         projectIPAccessListModel.Timeouts,
 	}
 }
@@ -266,8 +285,7 @@ func (r *ProjectIPAccessListRS) Read(ctx context.Context, req resource.ReadReque
 			// case 404
 			// deleted in the backend case
 			if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
-				resp.State.RemoveResource(ctx)
-				resp.Diagnostics.AddError("resource not found", err.Error())
+				removeExternallyDeletedFrameworkResource(ctx, r.client, &resp.State, &resp.Diagnostics, "mongodbatlas_project_ip_access_list", projectIPAccessListModelState.ID.ValueString())
 				return nil
 			}
 
@@ -392,6 +410,50 @@ func isEntryInProjectAccessList(ctx context.Context, conn *matlas.Client, projec
 	return &out, true, nil
 }
 
-// Update is not supported
+// waitForProjectIPAccessListActive waits until the entry is ACTIVE on all the clusters of the project, as the
+// clusters reject connections from an entry while it's PENDING.
+func waitForProjectIPAccessListActive(ctx context.Context, conn *matlas.Client, projectID, entry string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			req, err := conn.NewRequest(ctx, http.MethodGet, fmt.Sprintf(projectIPAccessListStatusPath, projectID, url.PathEscape(entry)), nil)
+			if err != nil {
+				return nil, "", err
+			}
+
+			root := new(projectIPAccessListStatus)
+			httpResponse, err := conn.Do(ctx, req, root)
+			if err != nil {
+				// the status isn't available right after the entry is created
+				if httpResponse != nil && (httpResponse.StatusCode == http.StatusNotFound || httpResponse.StatusCode == http.StatusInternalServerError) {
+					return root, "PENDING", nil
+				}
+				return nil, "", err
+			}
+
+			return root, root.Status, nil
+		},
+		Timeout:    projectIPAccessListTimeout,
+		Delay:      projectIPAccessListDelay,
+		MinTimeout: projectIPAccessListMinTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// Update only stores `wait_for_active` and `timeouts`, as the other attributes require replacement
 func (r *ProjectIPAccessListRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var projectIPAccessListModelState, projectIPAccessListModelPlan *tfProjectIPAccessListModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &projectIPAccessListModelState)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &projectIPAccessListModelPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectIPAccessListModelState.WaitForActive = projectIPAccessListModelPlan.WaitForActive
+	projectIPAccessListModelState.Timeouts = projectIPAccessListModelPlan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &projectIPAccessListModelState)...)
 }
@@ -94,6 +94,37 @@ func TestAccProjectRSProjectIPAccessList_SettingCIDRBlock(t *testing.T) {
 	})
 }
 
+func TestAccProjectRSProjectIPAccessList_WaitForActive(t *testing.T) {
+	resourceName := "mongodbatlas_project_ip_access_list.test"
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+	projectName := acctest.RandomWithPrefix("test-acc")
+	cidrBlock := fmt.Sprintf("179.154.226.%d/32", acctest.RandIntRange(0, 255))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectIPAccessListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectIPAccessListConfigWaitForActive(orgID, projectName, cidrBlock, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectIPAccessListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", cidrBlock),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_active", "true"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectIPAccessListConfigWaitForActive(orgID, projectName, cidrBlock, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectIPAccessListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", cidrBlock),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_active", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProjectRSProjectIPAccessList_SettingAWSSecurityGroup(t *testing.T) {
 	SkipTestExtCred(t)
 	resourceName := "mongodbatlas_project_ip_access_list.test"
@@ -331,6 +362,21 @@ func testAccMongoDBAtlasProjectIPAccessListConfigSettingCIDRBlock(orgID, project
 	`, orgID, projectName, cidrBlock, comment)
 }
 
+func testAccMongoDBAtlasProjectIPAccessListConfigWaitForActive(orgID, projectName, cidrBlock string, waitForActive bool) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name   = %[2]q
+			org_id = %[1]q
+		}
+
+		resource "mongodbatlas_project_ip_access_list" "test" {
+			project_id      = mongodbatlas_project.test.id
+			cidr_block      = %[3]q
+			wait_for_active = %[4]t
+		}
+	`, orgID, projectName, cidrBlock, waitForActive)
+}
+
 func testAccMongoDBAtlasProjectIPAccessListConfigSettingAWSSecurityGroup(projectID, providerName, vpcID, awsAccountID, vpcCIDRBlock, awsRegion, awsSGroup, comment string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_network_container" "test" {
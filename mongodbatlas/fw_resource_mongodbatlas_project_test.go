@@ -2,6 +2,7 @@ package mongodbatlas
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -9,13 +10,29 @@ import (
 	"strings"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mwielbut/pointy"
 	"go.mongodb.org/atlas-sdk/v20231001001/admin"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+func init() {
+	resource.AddTestSweepers("mongodbatlas_project", &resource.Sweeper{
+		Name: "mongodbatlas_project",
+		Dependencies: []string{
+			"mongodbatlas_advanced_cluster",
+			"mongodbatlas_serverless_instance",
+			"mongodbatlas_privatelink_endpoint",
+			"mongodbatlas_database_user",
+		},
+		F: testSweepProjects,
+	})
+}
+
 func TestAccProjectRSProject_basic(t *testing.T) {
 	var (
 		project      matlas.Project
@@ -287,10 +304,72 @@ func TestAccProjectRSProject_importBasic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"with_default_alerts_settings"},
 			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccImportByNamesStateIDFunc(resourceName, "name"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"with_default_alerts_settings"},
+			},
 		},
 	})
 }
 
+func TestAccProjectRSProject_forceDestroyWithCluster(t *testing.T) {
+	var (
+		projectName  = acctest.RandomWithPrefix("tf-acc-project")
+		clusterName  = acctest.RandomWithPrefix("test-acc")
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		resourceName = "mongodbatlas_project.test"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectConfigWithForceDestroy(projectName, orgID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+					testAccCreateUnmanagedTenantCluster(resourceName, clusterName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCreateUnmanagedTenantCluster creates a cluster outside of Terraform so destroying the project has to delete it.
+func testAccCreateUnmanagedTenantCluster(resourceName, clusterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testMongoDBClient.(*MongoDBClient).Atlas
+		_, _, err := conn.AdvancedClusters.Create(context.Background(), rs.Primary.ID, &matlas.AdvancedCluster{
+			Name:        clusterName,
+			ClusterType: "REPLICASET",
+			ReplicationSpecs: []*matlas.AdvancedReplicationSpec{
+				{
+					RegionConfigs: []*matlas.AdvancedRegionConfig{
+						{
+							ProviderName:        "TENANT",
+							BackingProviderName: "AWS",
+							RegionName:          "US_EAST_1",
+							Priority:            pointy.Int(7),
+							ElectableSpecs:      &matlas.Specs{InstanceSize: "M0"},
+						},
+					},
+				},
+			},
+		})
+
+		return err
+	}
+}
+
 func TestAccProjectRSProject_withUpdatedLimits(t *testing.T) {
 	var (
 		resourceName = "mongodbatlas_project.test"
@@ -441,6 +520,38 @@ func TestAccProjectRSProject_withInvalidLimitNameOnUpdate(t *testing.T) {
 	})
 }
 
+func TestWarnRemovedProjectTeams(t *testing.T) {
+	ctx := context.Background()
+	newTeam := func(teamID string) tfTeamModel {
+		roleNames, _ := types.SetValueFrom(ctx, types.StringType, []string{"GROUP_READ_ONLY"})
+		return tfTeamModel{TeamID: types.StringValue(teamID), RoleNames: roleNames}
+	}
+	teams, _ := types.SetValueFrom(ctx, tfTeamObjectType, []tfTeamModel{newTeam("team-kept"), newTeam("team-deleted")})
+	projectState := &tfProjectRSModel{ID: types.StringValue("project-id"), Teams: teams}
+	atlasTeams := &matlas.TeamsAssigned{Results: []*matlas.Result{{TeamID: "team-kept"}}}
+
+	resp := &fwresource.ReadResponse{}
+	warnRemovedProjectTeams(ctx, projectState, atlasTeams, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %d: %v", len(warnings), warnings)
+	}
+	if detail := warnings[0].Detail(); !strings.Contains(detail, "team-deleted") || !strings.Contains(detail, "project-id") {
+		t.Errorf("unexpected warning detail: %s", detail)
+	}
+
+	resp = &fwresource.ReadResponse{}
+	atlasTeams.Results = append(atlasTeams.Results, &matlas.Result{TeamID: "team-deleted"})
+	warnRemovedProjectTeams(ctx, projectState, atlasTeams, resp)
+	if count := resp.Diagnostics.WarningsCount(); count != 0 {
+		t.Errorf("expected no warnings when all teams are assigned, got %d", count)
+	}
+}
+
 func testAccCheckMongoDBAtlasProjectExists(resourceName string, project *matlas.Project) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testMongoDBClient.(*MongoDBClient).Atlas
@@ -609,3 +720,44 @@ func testAccMongoDBAtlasProjectConfigWithLimits(projectName, orgID string, limit
 		}
 	`, projectName, orgID, limitsString)
 }
+
+func testAccMongoDBAtlasProjectConfigWithForceDestroy(projectName, orgID string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			name          = %[1]q
+			org_id        = %[2]q
+			force_destroy = true
+		}
+	`, projectName, orgID)
+}
+
+// testSweepProjects deletes the projects left by the acceptance tests, along with the clusters, serverless
+// instances, private endpoints and network peering connections that would prevent it. deleteProjectDependents
+// waits until those are terminated, so the project is only deleted once it is empty.
+func testSweepProjects(_ string) error {
+	client, orgID, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	conn := client.Atlas
+	projects, err := sweepableProjects(ctx, conn, orgID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, project := range projects {
+		log.Printf("[INFO] sweeping project %s (%s)", project.Name, project.ID)
+		if err = deleteProjectDependents(ctx, conn, project.ID); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting the dependents of project %s: %s", project.ID, err))
+			continue
+		}
+		if err = deleteProject(ctx, conn, project.ID); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting project %s: %s", project.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
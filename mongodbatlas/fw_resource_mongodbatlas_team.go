@@ -0,0 +1,531 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+
+	conversion "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+)
+
+const (
+	teamResourceName  = "team"
+	teamsResourceName = "teams"
+	errorTeamCreate   = "error creating Team information: %s"
+	errorTeamAddUsers = "error adding users to the Team information: %s"
+	errorTeamRead     = "error getting Team information: %s"
+	errorTeamUpdate   = "error updating Team information: %s"
+	errorTeamDelete   = "error deleting Team (%s): %s"
+	errorTeamSetting  = "error setting `%s` for Team (%s): %s"
+)
+
+var _ resource.ResourceWithConfigure = &TeamRS{}
+var _ resource.ResourceWithImportState = &TeamRS{}
+var _ resource.ResourceWithModifyPlan = &TeamRS{}
+
+func NewTeamRS() resource.Resource {
+	return &TeamRS{
+		RSCommon: RSCommon{
+			resourceName: teamResourceName,
+		},
+	}
+}
+
+// NewTeamsRS returns the `mongodbatlas_teams` resource, kept as an alias of `mongodbatlas_team`
+// to preserve compatibility with configurations created before the resource was renamed.
+func NewTeamsRS() resource.Resource {
+	return &TeamRS{
+		RSCommon: RSCommon{
+			resourceName: teamsResourceName,
+		},
+	}
+}
+
+type TeamRS struct {
+	RSCommon
+}
+
+type tfTeamRSModel struct {
+	Usernames types.Set    `tfsdk:"usernames"`
+	ID        types.String `tfsdk:"id"`
+	OrgID     types.String `tfsdk:"org_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+func (r *TeamRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"usernames": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *TeamRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var teamPlan tfTeamRSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &teamPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	orgID := teamPlan.OrgID.ValueString()
+	usernames := conversion.TypesSetToString(ctx, teamPlan.Usernames)
+
+	// users without an Atlas account would fail the creation, they're left out until they have one
+	unknownUsernames, err := getUnknownTeamUsernames(ctx, conn, usernames)
+	if err != nil {
+		resp.Diagnostics.AddError("error during team creation", fmt.Sprintf(errorTeamCreate, err))
+		return
+	}
+
+	teamsResp, _, err := conn.Teams.Create(ctx, orgID,
+		&matlas.Team{
+			Name:      teamPlan.Name.ValueString(),
+			Usernames: removeTeamUsernames(usernames, unknownUsernames),
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("error during team creation", fmt.Sprintf(errorTeamCreate, err))
+		return
+	}
+
+	teamModel, err := newTFTeamRSModel(ctx, conn, orgID, teamsResp.ID, usernames)
+	if err != nil {
+		resp.Diagnostics.AddError("error when getting team after create", err.Error())
+		return
+	}
+
+	addUnknownTeamUsernamesWarning(&resp.Diagnostics, teamModel, teamPlan.Usernames, unknownUsernames)
+	resp.Diagnostics.Append(resp.State.Set(ctx, teamModel)...)
+}
+
+func (r *TeamRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var teamState tfTeamRSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &teamState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	ids := decodeStateID(teamState.ID.ValueString())
+	orgID := ids["org_id"]
+	teamID := ids["id"]
+
+	_, httpResp, err := conn.Teams.Get(ctx, orgID, teamID)
+	if err != nil {
+		// deleted in the backend case
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			removeExternallyDeletedFrameworkResource(ctx, r.client, &resp.State, &resp.Diagnostics, "mongodbatlas_team", teamState.ID.ValueString())
+			return
+		}
+		resp.Diagnostics.AddError("error when getting team from Atlas", fmt.Sprintf(errorTeamRead, err))
+		return
+	}
+
+	teamModel, err := newTFTeamRSModel(ctx, conn, orgID, teamID, conversion.TypesSetToString(ctx, teamState.Usernames))
+	if err != nil {
+		resp.Diagnostics.AddError("error when getting team from Atlas", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, teamModel)...)
+}
+
+func (r *TeamRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var teamState tfTeamRSModel
+	var teamPlan tfTeamRSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &teamState)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &teamPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	ids := decodeStateID(teamState.ID.ValueString())
+	orgID := ids["org_id"]
+	teamID := ids["id"]
+
+	if !teamPlan.Name.Equal(teamState.Name) {
+		if _, _, err := conn.Teams.Rename(ctx, orgID, teamID, teamPlan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("error in team name update", fmt.Sprintf(errorTeamUpdate, err))
+			return
+		}
+	}
+
+	usernames := conversion.TypesSetToString(ctx, teamPlan.Usernames)
+	var unknownUsernames []string
+	// Atlas lowercases the usernames, so changing only their case doesn't update the team
+	if !equalTeamUsernames(usernames, conversion.TypesSetToString(ctx, teamState.Usernames)) {
+		var err error
+		unknownUsernames, err = updateTeamUsers(ctx, conn, orgID, teamID, usernames)
+		if err != nil {
+			resp.Diagnostics.AddError("error in team usernames update", err.Error())
+			return
+		}
+	}
+
+	teamModel, err := newTFTeamRSModel(ctx, conn, orgID, teamID, usernames)
+	if err != nil {
+		resp.Diagnostics.AddError("error when getting team after update", err.Error())
+		return
+	}
+
+	addUnknownTeamUsernamesWarning(&resp.Diagnostics, teamModel, teamPlan.Usernames, unknownUsernames)
+	resp.Diagnostics.Append(resp.State.Set(ctx, teamModel)...)
+}
+
+// ModifyPlan warns about the added usernames without an Atlas account in the organization. These users are left out
+// of the team when it's applied, instead of failing halfway through it.
+func (r *TeamRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var teamPlan, teamState tfTeamRSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &teamPlan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &teamState)...)
+	}
+	if resp.Diagnostics.HasError() || teamPlan.Usernames.IsUnknown() || teamPlan.OrgID.IsUnknown() {
+		return
+	}
+
+	stateUsernames := conversion.TypesSetToString(ctx, teamState.Usernames)
+	var addedUsernames []string
+	for _, username := range conversion.TypesSetToString(ctx, teamPlan.Usernames) {
+		if !containsTeamUsername(stateUsernames, username) {
+			addedUsernames = append(addedUsernames, username)
+		}
+	}
+
+	for _, username := range addedUsernames {
+		user, httpResp, err := r.client.Atlas.AtlasUsers.GetByName(ctx, username)
+		switch {
+		case httpResp != nil && httpResp.StatusCode == http.StatusNotFound:
+			resp.Diagnostics.AddAttributeWarning(path.Root("usernames"), "username without Atlas account",
+				fmt.Sprintf("%s has no Atlas account, the user will be left out of the team until they sign up to Atlas.", username))
+		case err != nil:
+			// the users can't always be read, e.g. with the keys of another organization, so the plan goes on
+			tflog.Warn(ctx, fmt.Sprintf("error fetching information user for (%s): %s", username, err))
+		case !isAtlasUserInOrg(user, teamPlan.OrgID.ValueString()):
+			resp.Diagnostics.AddAttributeWarning(path.Root("usernames"), "username without Atlas account in the organization",
+				fmt.Sprintf("%s isn't a member of the organization %s, Atlas may reject adding the user to the team.", username, teamPlan.OrgID.ValueString()))
+		}
+	}
+}
+
+func (r *TeamRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var teamState tfTeamRSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &teamState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	ids := decodeStateID(teamState.ID.ValueString())
+	orgID := ids["org_id"]
+	id := ids["id"]
+
+	err := retry.RetryContext(ctx, 1*time.Hour, func() *retry.RetryError {
+		_, err := conn.Teams.RemoveTeamFromOrganization(ctx, orgID, id)
+		if err != nil {
+			var target *matlas.ErrorResponse
+			if errors.As(err, &target) && target.ErrorCode == "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT" {
+				projectID, err := getProjectIDByTeamID(ctx, conn, id)
+				if err != nil {
+					return retry.NonRetryableError(err)
+				}
+
+				_, err = conn.Teams.RemoveTeamFromProject(ctx, projectID, id)
+				if err != nil {
+					return retry.NonRetryableError(fmt.Errorf(errorTeamDelete, id, err))
+				}
+				return retry.RetryableError(fmt.Errorf("will retry again"))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error when destroying resource", fmt.Sprintf(errorTeamDelete, id, err))
+		return
+	}
+}
+
+func (r *TeamRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var orgID, teamID string
+	if names, ok := splitImportNames(req.ID, 2); ok {
+		var err error
+		orgID, teamID, err = resolveTeamID(ctx, r.client.Atlas, names[0], names[1])
+		if err != nil {
+			resp.Diagnostics.AddError("error when importing team", err.Error())
+			return
+		}
+	} else {
+		parts := strings.SplitN(req.ID, "-", 2)
+		if len(parts) != 2 {
+			resp.Diagnostics.AddError("import format error", "to import a team, use the format {org_id}-{team_id} or {org_name}/{team_name}")
+			return
+		}
+		orgID = parts[0]
+		teamID = parts[1]
+	}
+
+	u, _, err := r.client.Atlas.Teams.Get(ctx, orgID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("error when importing team", fmt.Sprintf("couldn't import team (%s) in organization(%s), error: %s", teamID, orgID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), u.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), encodeStateID(map[string]string{
+		"org_id": orgID,
+		"id":     u.ID,
+	}))...)
+}
+
+// newTFTeamRSModel reads the team from Atlas. The usernames are kept with the case of the given ones, as Atlas
+// lowercases them.
+func newTFTeamRSModel(ctx context.Context, conn *matlas.Client, orgID, teamID string, configuredUsernames []string) (*tfTeamRSModel, error) {
+	team, _, err := conn.Teams.Get(ctx, orgID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf(errorTeamRead, err)
+	}
+
+	users, _, err := conn.Teams.GetTeamUsersAssigned(ctx, orgID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf(errorTeamRead, err)
+	}
+
+	usernames := make([]string, len(users))
+	for i := range users {
+		usernames[i] = users[i].Username
+		for _, configuredUsername := range configuredUsernames {
+			if strings.EqualFold(configuredUsername, users[i].Username) {
+				usernames[i] = configuredUsername
+				break
+			}
+		}
+	}
+
+	usernamesSet, diags := types.SetValueFrom(ctx, types.StringType, usernames)
+	if diags.HasError() {
+		return nil, fmt.Errorf(errorTeamSetting, "usernames", teamID, diags.Errors()[0].Detail())
+	}
+
+	// ID is encoded to preserve format defined in previous versions.
+	encodedID := encodeStateID(map[string]string{
+		"org_id": orgID,
+		"id":     team.ID,
+	})
+
+	return &tfTeamRSModel{
+		ID:        types.StringValue(encodedID),
+		OrgID:     types.StringValue(orgID),
+		TeamID:    types.StringValue(team.ID),
+		Name:      types.StringValue(team.Name),
+		Usernames: usernamesSet,
+	}, nil
+}
+
+// updateTeamUsers replaces the users of a team with the given usernames. The users assigned to the team are only
+// removed once all the new usernames have been resolved, so a failed lookup doesn't leave the team without users.
+// The usernames without an Atlas account are left out and returned.
+func updateTeamUsers(ctx context.Context, conn *matlas.Client, orgID, teamID string, usernames []string) ([]string, error) {
+	users, _, err := conn.Teams.GetTeamUsersAssigned(ctx, orgID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf(errorTeamRead, err)
+	}
+
+	// existing users, Atlas lowercases the usernames
+	index := make(map[string]matlas.AtlasUser)
+	for i := range users {
+		index[strings.ToLower(users[i].Username)] = users[i]
+	}
+
+	var newUsers, unknownUsernames []string
+	for _, username := range usernames {
+		user, httpResp, err := conn.AtlasUsers.GetByName(ctx, username)
+		updatedUserData := user
+
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				unknownUsernames = append(unknownUsernames, username)
+				continue
+			}
+
+			// this must be handle as a soft error
+			if !strings.Contains(err.Error(), "401") {
+				return nil, fmt.Errorf("error getting Atlas User (%s) information: %s", username, err)
+			}
+
+			tflog.Warn(ctx, fmt.Sprintf("error fetching information user for (%s): %s", username, err))
+			if user == nil {
+				cached, ok := index[strings.ToLower(username)]
+				if !ok {
+					return nil, fmt.Errorf("error getting Atlas User (%s) information: %s", username, err)
+				}
+				updatedUserData = &cached
+			}
+		}
+		// if the user exists, we will storage its teamID
+		newUsers = append(newUsers, updatedUserData.ID)
+	}
+
+	for i := range users {
+		if _, err := conn.Teams.RemoveUserToTeam(ctx, orgID, teamID, users[i].ID); err != nil {
+			return nil, fmt.Errorf("error deleting Atlas User (%s) information: %s", teamID, err)
+		}
+	}
+
+	if len(newUsers) > 0 {
+		if _, _, err := conn.Teams.AddUsersToTeam(ctx, orgID, teamID, newUsers); err != nil {
+			return nil, fmt.Errorf(errorTeamAddUsers, err)
+		}
+	}
+
+	return unknownUsernames, nil
+}
+
+// getUnknownTeamUsernames returns the usernames without an Atlas account.
+func getUnknownTeamUsernames(ctx context.Context, conn *matlas.Client, usernames []string) ([]string, error) {
+	var unknownUsernames []string
+	for _, username := range usernames {
+		_, httpResp, err := conn.AtlasUsers.GetByName(ctx, username)
+		if err == nil {
+			continue
+		}
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			unknownUsernames = append(unknownUsernames, username)
+			continue
+		}
+		// the users can't always be read, e.g. with the keys of another organization, Atlas checks them on creation
+		if !strings.Contains(err.Error(), "401") {
+			return nil, fmt.Errorf("error getting Atlas User (%s) information: %s", username, err)
+		}
+		tflog.Warn(ctx, fmt.Sprintf("error fetching information user for (%s): %s", username, err))
+	}
+
+	return unknownUsernames, nil
+}
+
+// addUnknownTeamUsernamesWarning warns about the usernames left out of the team. The planned usernames are kept in the
+// state, as Terraform requires, so the next plan adds them again once they have an Atlas account.
+func addUnknownTeamUsernamesWarning(diags *diag.Diagnostics, teamModel *tfTeamRSModel, plannedUsernames types.Set, unknownUsernames []string) {
+	if len(unknownUsernames) == 0 {
+		return
+	}
+
+	teamModel.Usernames = plannedUsernames
+	diags.AddAttributeWarning(path.Root("usernames"), "usernames without Atlas account",
+		fmt.Sprintf("%s have no Atlas account and were left out of the team.", strings.Join(unknownUsernames, ", ")))
+}
+
+func removeTeamUsernames(usernames, removed []string) []string {
+	var result []string
+	for _, username := range usernames {
+		if !containsTeamUsername(removed, username) {
+			result = append(result, username)
+		}
+	}
+	return result
+}
+
+func equalTeamUsernames(usernames, otherUsernames []string) bool {
+	if len(usernames) != len(otherUsernames) {
+		return false
+	}
+	for _, username := range usernames {
+		if !containsTeamUsername(otherUsernames, username) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsTeamUsername(usernames []string, username string) bool {
+	for _, u := range usernames {
+		if strings.EqualFold(u, username) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAtlasUserInOrg(user *matlas.AtlasUser, orgID string) bool {
+	if user == nil {
+		return true
+	}
+	for _, role := range user.Roles {
+		if role.OrgID == orgID {
+			return true
+		}
+	}
+	return false
+}
+
+func getProjectIDByTeamID(ctx context.Context, conn *matlas.Client, teamID string) (string, error) {
+	options := &matlas.ListOptions{}
+	projects, _, err := conn.Projects.GetAllProjects(ctx, options)
+	if err != nil {
+		return "", fmt.Errorf("error getting projects information: %s", err)
+	}
+
+	for _, project := range projects.Results {
+		teams, _, err := conn.Projects.GetProjectTeamsAssigned(ctx, project.ID)
+		if err != nil {
+			return "", fmt.Errorf("error getting teams from project information: %s", err)
+		}
+
+		for _, team := range teams.Results {
+			if team.TeamID == teamID {
+				return project.ID, nil
+			}
+		}
+	}
+
+	return "", nil
+}
@@ -2,6 +2,7 @@ package mongodbatlas
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -14,6 +15,15 @@ import (
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+func init() {
+	resource.AddTestSweepers("mongodbatlas_team", &resource.Sweeper{
+		Name: "mongodbatlas_team",
+		// the teams are removed from the swept projects along with them
+		Dependencies: []string{"mongodbatlas_project"},
+		F:            testSweepTeams,
+	})
+}
+
 func TestAccConfigRSTeam_basic(t *testing.T) {
 	var (
 		team         matlas.Team
@@ -75,6 +85,45 @@ func TestAccConfigRSTeam_basic(t *testing.T) {
 	})
 }
 
+func TestAccConfigRSTeam_usernamesCase(t *testing.T) {
+	var (
+		team         matlas.Team
+		resourceName = "mongodbatlas_teams.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		username     = strings.ToUpper(os.Getenv("MONGODB_ATLAS_USERNAME_CLOUD_DEV"))
+		unknownUser  = fmt.Sprintf("test-acc-%s@mongodb.com", acctest.RandString(10))
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasTeamConfig(orgID, name, []string{username}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamExists(resourceName, &team),
+					resource.TestCheckTypeSetElemAttr(resourceName, "usernames.*", username),
+				),
+			},
+			{
+				Config:   testAccMongoDBAtlasTeamConfig(orgID, name, []string{username}),
+				PlanOnly: true,
+			},
+			{
+				// the unknown user is left out of the team with a warning, so the plan isn't empty afterwards
+				Config:             testAccMongoDBAtlasTeamConfig(orgID, name, []string{username, unknownUser}),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamExists(resourceName, &team),
+					resource.TestCheckResourceAttr(resourceName, "usernames.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccConfigRSTeam_importBasic(t *testing.T) {
 	var (
 		resourceName = "mongodbatlas_teams.test"
@@ -107,6 +156,12 @@ func TestAccConfigRSTeam_importBasic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccImportByNamesStateIDFunc(resourceName, "name"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -196,3 +251,37 @@ func testAccMongoDBAtlasTeamConfig(orgID, name string, usernames []string) strin
 		strings.ReplaceAll(fmt.Sprintf("%+q", usernames), " ", ","),
 	)
 }
+
+// testSweepTeams deletes the teams of the organization created by the acceptance tests.
+func testSweepTeams(_ string) error {
+	client, orgID, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	conn := client.Atlas
+
+	var errs []error
+	for page := 1; ; page++ {
+		teams, _, err := conn.Teams.List(ctx, orgID, &matlas.ListOptions{PageNum: page, ItemsPerPage: sweeperItemsPerPage})
+		if err != nil {
+			return fmt.Errorf("error listing the teams of organization %s: %s", orgID, err)
+		}
+
+		for _, team := range teams {
+			if !isSweepable(team.Name) {
+				continue
+			}
+
+			log.Printf("[INFO] sweeping team %s (%s)", team.Name, team.ID)
+			if _, err := conn.Teams.RemoveTeamFromOrganization(ctx, orgID, team.ID); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting team %s: %s", team.ID, err))
+			}
+		}
+
+		if len(teams) < sweeperItemsPerPage {
+			return errors.Join(errs...)
+		}
+	}
+}
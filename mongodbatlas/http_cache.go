@@ -0,0 +1,137 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// EnvRequestCacheTTL enables the cache of GET responses and sets how long they're reused, e.g. "10s". The cache is
+// disabled unless it's set, as the SDKv2 and framework providers have their own clients, which don't clear the cache
+// of each other, and resources polling Atlas faster than the TTL would wait on cached responses.
+const EnvRequestCacheTTL = "MONGODB_ATLAS_REQUEST_CACHE_TTL"
+
+// requestCacheTransport reuses the responses of identical GET requests sent within a short TTL, so data sources
+// and resources reading the same projects or clusters during one Terraform operation don't repeat the same API
+// calls. Concurrent identical requests wait for the first one instead of being sent in parallel. Any other request
+// may change what Atlas returns, so it clears the cache.
+type requestCacheTransport struct {
+	transport http.RoundTripper
+	ttl       time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+}
+
+type cachedResponse struct {
+	done       chan struct{}
+	expires    time.Time
+	cacheable  bool
+	statusCode int
+	status     string
+	header     http.Header
+	body       []byte
+}
+
+func newRequestCacheTransport(transport http.RoundTripper) http.RoundTripper {
+	v := os.Getenv(EnvRequestCacheTTL)
+	if v == "" {
+		return transport
+	}
+	ttl, err := time.ParseDuration(v)
+	if err != nil {
+		log.Printf("[WARN] ignoring invalid %s %q: %s", EnvRequestCacheTTL, v, err)
+		return transport
+	}
+	if ttl <= 0 {
+		return transport
+	}
+
+	return &requestCacheTransport{
+		transport: transport,
+		ttl:       ttl,
+		now:       time.Now,
+		entries:   map[string]*cachedResponse{},
+	}
+}
+
+func (t *requestCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		t.invalidate()
+		return t.transport.RoundTrip(req)
+	}
+
+	key := req.Header.Get("Accept") + " " + req.URL.String()
+
+	t.mu.Lock()
+	if entry, ok := t.entries[key]; ok {
+		t.mu.Unlock()
+		<-entry.done
+		if entry.cacheable && t.now().Before(entry.expires) {
+			log.Printf("[DEBUG] reusing cached response of GET %s", req.URL.Path)
+			return entry.response(req), nil
+		}
+		t.remove(key, entry)
+		return t.RoundTrip(req)
+	}
+	entry := &cachedResponse{done: make(chan struct{})}
+	t.entries[key] = entry
+	t.mu.Unlock()
+	defer close(entry.done)
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		t.remove(key, entry)
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.remove(key, entry)
+		return nil, err
+	}
+
+	entry.statusCode = resp.StatusCode
+	entry.status = resp.Status
+	entry.header = resp.Header.Clone()
+	entry.body = body
+	entry.expires = t.now().Add(t.ttl)
+	entry.cacheable = true
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (t *requestCacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries = map[string]*cachedResponse{}
+}
+
+func (t *requestCacheTransport) remove(key string, entry *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if current, ok := t.entries[key]; ok && current == entry {
+		delete(t.entries, key)
+	}
+}
+
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        c.status,
+		StatusCode:    c.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
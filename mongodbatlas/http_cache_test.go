@@ -0,0 +1,113 @@
+package mongodbatlas
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestCacheTransport(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"results":[]}`))
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	transport := &requestCacheTransport{
+		transport: http.DefaultTransport,
+		ttl:       time.Minute,
+		now:       func() time.Time { return now },
+		entries:   map[string]*cachedResponse{},
+	}
+	client := &http.Client{Transport: transport}
+
+	send := func(method, path string) string {
+		req, err := http.NewRequest(method, server.URL+path, http.NoBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+	expectHits := func(expected int32) {
+		t.Helper()
+		if got := atomic.LoadInt32(&hits); got != expected {
+			t.Errorf("server hits = %d, want %d", got, expected)
+		}
+	}
+
+	if body := send(http.MethodGet, "/groups"); body != `{"results":[]}` {
+		t.Errorf("body = %q", body)
+	}
+	if body := send(http.MethodGet, "/groups"); body != `{"results":[]}` {
+		t.Errorf("cached body = %q", body)
+	}
+	expectHits(1)
+
+	send(http.MethodGet, "/groups?pageNum=2")
+	expectHits(2)
+
+	send(http.MethodGet, "/missing")
+	send(http.MethodGet, "/missing")
+	expectHits(4)
+
+	send(http.MethodPatch, "/groups/1")
+	send(http.MethodGet, "/groups")
+	expectHits(6)
+
+	now = now.Add(2 * time.Minute)
+	send(http.MethodGet, "/groups")
+	expectHits(7)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			send(http.MethodGet, "/clusters")
+		}()
+	}
+	wg.Wait()
+	expectHits(8)
+}
+
+func TestNewRequestCacheTransport(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ttl    string
+		cached bool
+	}{
+		{name: "not set", ttl: "", cached: false},
+		{name: "disabled", ttl: "0", cached: false},
+		{name: "invalid", ttl: "ten seconds", cached: false},
+		{name: "enabled", ttl: "10s", cached: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(EnvRequestCacheTTL, tc.ttl)
+			_, cached := newRequestCacheTransport(http.DefaultTransport).(*requestCacheTransport)
+			if cached != tc.cached {
+				t.Errorf("cached = %t, want %t", cached, tc.cached)
+			}
+		})
+	}
+}
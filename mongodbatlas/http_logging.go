@@ -0,0 +1,167 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// EnvTFLogAtlas enables the HTTP debug logging and sets the level of the atlas log subsystem (e.g. DEBUG or TRACE).
+	EnvTFLogAtlas          = "TF_LOG_ATLAS"
+	atlasLoggingSubsystem  = "atlas"
+	atlasRequestIDHeader   = "X-Request-Id"
+	redactedValue          = "REDACTED"
+	maxLoggedHTTPBodyBytes = 32 * 1024
+)
+
+var (
+	sensitiveHTTPHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Www-Authenticate",
+		"Cookie",
+		"Set-Cookie",
+	}
+	sensitiveJSONField = regexp.MustCompile(`(?i)(password|secret|token|credential|key$)`)
+)
+
+// isDebugLoggingEnabled returns true when the HTTP debug logging was enabled in the provider configuration
+// or with the TF_LOG_ATLAS environment variable.
+func isDebugLoggingEnabled(debugLogging bool) bool {
+	return debugLogging || os.Getenv(EnvTFLogAtlas) != ""
+}
+
+// loggingTransport logs every HTTP request and response through tflog, redacting the digest auth headers
+// and any secret contained in the JSON bodies.
+type loggingTransport struct {
+	transport http.RoundTripper
+	name      string
+}
+
+func newLoggingTransport(name string, transport http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{
+		name:      name,
+		transport: transport,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if os.Getenv(EnvTFLogAtlas) != "" {
+		ctx = tflog.NewSubsystem(ctx, atlasLoggingSubsystem, tflog.WithLevelFromEnv(EnvTFLogAtlas))
+	} else {
+		ctx = tflog.NewSubsystem(ctx, atlasLoggingSubsystem)
+	}
+
+	requestFields := map[string]interface{}{
+		"api":          t.name,
+		"http_method":  req.Method,
+		"http_url":     req.URL.String(),
+		"http_headers": redactHTTPHeaders(req.Header),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		requestFields["http_body"] = redactHTTPBody(body)
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		requestFields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, atlasLoggingSubsystem, "HTTP request", requestFields)
+		return resp, err
+	}
+
+	ctx = tflog.SubsystemSetField(ctx, atlasLoggingSubsystem, "atlas_request_id", resp.Header.Get(atlasRequestIDHeader))
+	tflog.SubsystemDebug(ctx, atlasLoggingSubsystem, "HTTP request", requestFields)
+
+	responseFields := map[string]interface{}{
+		"api":              t.name,
+		"http_status_code": resp.StatusCode,
+		"http_headers":     redactHTTPHeaders(resp.Header),
+		"http_duration_ms": time.Since(start).Milliseconds(),
+	}
+	if resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			return nil, readErr
+		}
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		responseFields["http_body"] = redactHTTPBody(body)
+	}
+	tflog.SubsystemDebug(ctx, atlasLoggingSubsystem, "HTTP response", responseFields)
+
+	return resp, nil
+}
+
+func redactHTTPHeaders(headers http.Header) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		redacted[name] = fmt.Sprint(values)
+	}
+	for _, name := range sensitiveHTTPHeaders {
+		if _, ok := redacted[name]; ok {
+			redacted[name] = redactedValue
+		}
+	}
+	return redacted
+}
+
+// redactHTTPBody replaces the values of secret-looking fields in a JSON body. Bodies that are not JSON
+// are not logged as their content can't be inspected.
+func redactHTTPBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<non-JSON body of %d bytes>", len(body))
+	}
+
+	redacted, err := json.Marshal(redactJSONValue(value))
+	if err != nil {
+		return fmt.Sprintf("<body of %d bytes>", len(body))
+	}
+	if len(redacted) > maxLoggedHTTPBodyBytes {
+		return fmt.Sprintf("%s... <truncated, %d bytes>", redacted[:maxLoggedHTTPBodyBytes], len(redacted))
+	}
+	return string(redacted)
+}
+
+func redactJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if sensitiveJSONField.MatchString(key) {
+				if _, isObject := val.(map[string]interface{}); !isObject {
+					v[key] = redactedValue
+					continue
+				}
+			}
+			v[key] = redactJSONValue(val)
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redactJSONValue(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,58 @@
+package mongodbatlas
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRedactHTTPBody(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "empty",
+			body:     "",
+			expected: "",
+		},
+		{
+			name:     "not JSON",
+			body:     "username=admin",
+			expected: "<non-JSON body of 14 bytes>",
+		},
+		{
+			name:     "nested secrets",
+			body:     `{"username":"admin","password":"pwd","ldap":{"bindPassword":"pwd","hostname":"host"},"results":[{"apiKey":"key","secretAccessKey":"secret"}]}`,
+			expected: `{"ldap":{"bindPassword":"REDACTED","hostname":"host"},"password":"REDACTED","results":[{"apiKey":"REDACTED","secretAccessKey":"REDACTED"}],"username":"admin"}`,
+		},
+		{
+			name:     "tokens",
+			body:     `{"access_token":"token","refresh_token":"token","user_id":"id"}`,
+			expected: `{"access_token":"REDACTED","refresh_token":"REDACTED","user_id":"id"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := redactHTTPBody([]byte(tc.body)); got != tc.expected {
+				t.Errorf("redactHTTPBody() = %s, want %s", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRedactHTTPHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", `Digest username="public", response="hash"`)
+	headers.Set("WWW-Authenticate", `Digest realm="MMS Public API", nonce="nonce"`)
+	headers.Set("Content-Type", "application/json")
+
+	redacted := redactHTTPHeaders(headers)
+	if redacted["Authorization"] != redactedValue || redacted["Www-Authenticate"] != redactedValue {
+		t.Errorf("auth headers were not redacted: %v", redacted)
+	}
+	if redacted["Content-Type"] != "[application/json]" {
+		t.Errorf("Content-Type = %s, want [application/json]", redacted["Content-Type"])
+	}
+}
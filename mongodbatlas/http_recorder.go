@@ -0,0 +1,278 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// EnvHTTPRecorderMode records the HTTP interactions with MongoDB Atlas in a cassette ("record") or answers the
+	// requests from a cassette without reaching Atlas ("replay"). It's meant for the acceptance tests.
+	EnvHTTPRecorderMode = "MONGODB_ATLAS_HTTP_RECORDER_MODE"
+	// EnvHTTPRecorderCassette is the path of the cassette file written when recording and read when replaying.
+	EnvHTTPRecorderCassette = "MONGODB_ATLAS_HTTP_CASSETTE"
+
+	httpRecorderModeRecord = "record"
+	httpRecorderModeReplay = "replay"
+	httpRecorderEnvPrefix  = "MONGODB_ATLAS_"
+)
+
+var (
+	httpRecorderOnce    sync.Once
+	httpRecorderErr     error
+	activeHTTPRecorder  *httpRecorder
+	sensitiveEnvVarName = regexp.MustCompile(`(?i)(password|secret|token|credential|key)`)
+)
+
+// httpCassette holds the recorded interactions along with the MongoDB Atlas environment variables of the recording,
+// e.g. the organization ID, which the tests must use again so the replayed requests match the recorded ones.
+type httpCassette struct {
+	Env          map[string]string  `json:"env,omitempty"`
+	Interactions []*httpInteraction `json:"interactions"`
+}
+
+type httpInteraction struct {
+	Header       http.Header `json:"header,omitempty"`
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Accept       string      `json:"accept,omitempty"`
+	RequestBody  string      `json:"request_body,omitempty"`
+	ResponseBody string      `json:"response_body,omitempty"`
+	StatusCode   int         `json:"status_code"`
+}
+
+// httpRecorder is shared by every client of the process, as the acceptance tests configure a new provider for each
+// step. Replayed interactions are matched by method, URL, Accept header and request body, and the interactions with
+// the same match are returned in the recorded order, repeating the last one, so polling a resource until it reaches a
+// state works the same way it did during the recording.
+type httpRecorder struct {
+	cassette *httpCassette
+	replay   map[string][]*httpInteraction
+	next     map[string]int
+	mode     string
+	path     string
+	mu       sync.Mutex
+}
+
+type httpRecorderTransport struct {
+	transport http.RoundTripper
+	recorder  *httpRecorder
+}
+
+// getHTTPRecorder returns the recorder of the process, loading the cassette once in replay mode, or nil when the
+// recorder isn't enabled.
+func getHTTPRecorder() (*httpRecorder, error) {
+	httpRecorderOnce.Do(func() {
+		mode := strings.ToLower(os.Getenv(EnvHTTPRecorderMode))
+		if mode == "" {
+			return
+		}
+
+		path := os.Getenv(EnvHTTPRecorderCassette)
+		if path == "" {
+			httpRecorderErr = fmt.Errorf("%s must be set along with %s", EnvHTTPRecorderCassette, EnvHTTPRecorderMode)
+			return
+		}
+
+		recorder := &httpRecorder{
+			mode:     mode,
+			path:     path,
+			cassette: &httpCassette{},
+			replay:   map[string][]*httpInteraction{},
+			next:     map[string]int{},
+		}
+
+		switch mode {
+		case httpRecorderModeRecord:
+		case httpRecorderModeReplay:
+			if httpRecorderErr = recorder.load(); httpRecorderErr != nil {
+				return
+			}
+		default:
+			httpRecorderErr = fmt.Errorf("invalid %s %q, expected %q or %q", EnvHTTPRecorderMode, mode, httpRecorderModeRecord, httpRecorderModeReplay)
+			return
+		}
+
+		activeHTTPRecorder = recorder
+	})
+
+	return activeHTTPRecorder, httpRecorderErr
+}
+
+func (t *httpRecorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		requestBody = body
+	}
+
+	interaction := &httpInteraction{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		Accept:      req.Header.Get("Accept"),
+		RequestBody: redactHTTPRecorderBody(requestBody),
+	}
+
+	if t.recorder.mode == httpRecorderModeReplay {
+		return t.recorder.replayInteraction(req, interaction)
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction.StatusCode = resp.StatusCode
+	interaction.Header = resp.Header.Clone()
+	// the body length changes once the secrets are redacted
+	interaction.Header.Del("Content-Length")
+	for _, name := range sensitiveHTTPHeaders {
+		interaction.Header.Del(name)
+	}
+	interaction.ResponseBody = redactHTTPRecorderBody(responseBody)
+	t.recorder.record(interaction)
+
+	return resp, nil
+}
+
+func (r *httpRecorder) record(interaction *httpInteraction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+}
+
+func (r *httpRecorder) replayInteraction(req *http.Request, interaction *httpInteraction) (*http.Response, error) {
+	key := interaction.key()
+
+	r.mu.Lock()
+	recorded := r.replay[key]
+	if len(recorded) == 0 {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("no interaction recorded in %s for %s %s, record the cassette again", r.path, req.Method, req.URL)
+	}
+	i := r.next[key]
+	if i < len(recorded)-1 {
+		r.next[key] = i + 1
+	}
+	r.mu.Unlock()
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded[i].StatusCode, http.StatusText(recorded[i].StatusCode)),
+		StatusCode:    recorded[i].StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded[i].Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(recorded[i].ResponseBody)),
+		ContentLength: int64(len(recorded[i].ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+func (r *httpRecorder) load() error {
+	content, err := os.ReadFile(r.path)
+	if err != nil {
+		return fmt.Errorf("error reading the HTTP cassette: %s", err)
+	}
+	if err = json.Unmarshal(content, r.cassette); err != nil {
+		return fmt.Errorf("error parsing the HTTP cassette %s: %s", r.path, err)
+	}
+
+	for _, interaction := range r.cassette.Interactions {
+		key := interaction.key()
+		r.replay[key] = append(r.replay[key], interaction)
+	}
+	return nil
+}
+
+// saveHTTPCassette writes the interactions recorded by the process to the cassette, together with the MongoDB Atlas
+// environment variables that don't hold credentials. It does nothing unless the recorder runs in record mode.
+func saveHTTPCassette() error {
+	recorder, err := getHTTPRecorder()
+	if err != nil || recorder == nil || recorder.mode != httpRecorderModeRecord {
+		return err
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.cassette.Env = map[string]string{}
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if strings.HasPrefix(name, httpRecorderEnvPrefix) && !sensitiveEnvVarName.MatchString(name) &&
+			name != EnvHTTPRecorderMode && name != EnvHTTPRecorderCassette {
+			recorder.cassette.Env[name] = value
+		}
+	}
+
+	content, err := json.MarshalIndent(recorder.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(recorder.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(recorder.path, content, 0o600)
+}
+
+// applyHTTPCassetteEnv sets the environment variables of the recording when the recorder runs in replay mode.
+func applyHTTPCassetteEnv() error {
+	recorder, err := getHTTPRecorder()
+	if err != nil || recorder == nil || recorder.mode != httpRecorderModeReplay {
+		return err
+	}
+
+	for name, value := range recorder.cassette.Env {
+		if err = os.Setenv(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isHTTPRecorderEnabled() bool {
+	return os.Getenv(EnvHTTPRecorderMode) != ""
+}
+
+func (i *httpInteraction) key() string {
+	return strings.Join([]string{i.Method, i.URL, i.Accept, i.RequestBody}, " ")
+}
+
+// redactHTTPRecorderBody replaces the secrets of JSON bodies, so cassettes can be committed. Other bodies, e.g. the
+// PEM certificates of X.509 users, are kept as they are. Unlike the logged bodies, they're never truncated.
+func redactHTTPRecorderBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactJSONValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
@@ -0,0 +1,106 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestHTTPRecorderTransport(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/clusters/test":
+			if atomic.AddInt32(&polls, 1) == 1 {
+				_, _ = w.Write([]byte(`{"stateName":"CREATING"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"stateName":"IDLE"}`))
+		case "/apiKeys":
+			w.Header().Set("Www-Authenticate", "Digest realm=test")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"key","privateKey":"secret"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	send := func(client *http.Client, method, path, body string) (int, string, error) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, "", err
+		}
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(respBody), nil
+	}
+
+	recorder := &httpRecorder{mode: httpRecorderModeRecord, cassette: &httpCassette{}}
+	recordClient := &http.Client{Transport: &httpRecorderTransport{transport: http.DefaultTransport, recorder: recorder}}
+	for i := 0; i < 2; i++ {
+		if _, _, err := send(recordClient, http.MethodGet, "/clusters/test", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, body, err := send(recordClient, http.MethodPost, "/apiKeys", `{"desc":"test"}`); err != nil || body != `{"id":"key","privateKey":"secret"}` {
+		t.Fatalf("recorded response = %q, %v, want the original body", body, err)
+	}
+
+	content, err := json.Marshal(recorder.cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), "secret") || strings.Contains(string(content), "Digest") {
+		t.Fatalf("cassette contains secrets: %s", content)
+	}
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err = os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	replayer := &httpRecorder{
+		mode:     httpRecorderModeReplay,
+		path:     path,
+		cassette: &httpCassette{},
+		replay:   map[string][]*httpInteraction{},
+		next:     map[string]int{},
+	}
+	if err = replayer.load(); err != nil {
+		t.Fatal(err)
+	}
+	replayClient := &http.Client{Transport: &httpRecorderTransport{transport: http.DefaultTransport, recorder: replayer}}
+
+	for _, expected := range []string{"CREATING", "IDLE", "IDLE"} {
+		_, body, sendErr := send(replayClient, http.MethodGet, "/clusters/test", "")
+		if sendErr != nil {
+			t.Fatal(sendErr)
+		}
+		if !strings.Contains(body, expected) {
+			t.Errorf("replayed body = %s, want state %s", body, expected)
+		}
+	}
+
+	status, body, err := send(replayClient, http.MethodPost, "/apiKeys", `{"desc":"test"}`)
+	if err != nil || status != http.StatusCreated || body != `{"id":"key","privateKey":"REDACTED"}` {
+		t.Errorf("replayed response = %d %q, %v, want the redacted body", status, body, err)
+	}
+
+	if _, _, err = send(replayClient, http.MethodPost, "/apiKeys", `{"desc":"other"}`); err == nil {
+		t.Error("expected an error for a request that wasn't recorded")
+	}
+}
@@ -0,0 +1,112 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+const (
+	conflictRetryMinBackoff = 10 * time.Second
+	conflictRetryMaxBackoff = time.Minute
+	conflictRetryMaxElapsed = 30 * time.Minute
+)
+
+// conflictRetryErrorCodes are the 409 error codes reporting a change still being applied, which succeed once it ends.
+var conflictRetryErrorCodes = map[string]bool{
+	"CLUSTER_CHANGE_IN_PROGRESS": true,
+}
+
+// conflictRetryTransport retries the requests rejected with 409 because another change is still being applied
+// to the same cluster (e.g. CLUSTER_CHANGE_IN_PROGRESS), so resources that modify the same cluster concurrently
+// don't need artificial depends_on chains.
+type conflictRetryTransport struct {
+	transport  http.RoundTripper
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	maxElapsed time.Duration
+}
+
+func newConflictRetryTransport(transport http.RoundTripper) http.RoundTripper {
+	return &conflictRetryTransport{
+		transport:  transport,
+		minBackoff: conflictRetryMinBackoff,
+		maxBackoff: conflictRetryMaxBackoff,
+		maxElapsed: conflictRetryMaxElapsed,
+	}
+}
+
+func (t *conflictRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return t.transport.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	start := time.Now()
+	backoff := t.minBackoff
+	for {
+		// a RoundTripper must not modify the request, so each attempt sends a clone with the body rewound
+		attempt := req.Clone(req.Context())
+		if body != nil {
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.transport.RoundTrip(attempt)
+		if err != nil || resp.StatusCode != http.StatusConflict {
+			return resp, err
+		}
+
+		errorCode, retryable := conflictErrorCode(resp)
+		if !retryable || time.Since(start)+backoff > t.maxElapsed {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		log.Printf("[DEBUG] %s %s returned 409 %s, retrying in %s", req.Method, req.URL.Path, errorCode, backoff)
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > t.maxBackoff {
+			backoff = t.maxBackoff
+		}
+	}
+}
+
+// conflictErrorCode returns the Atlas error code of a 409 response and whether it's one of conflictRetryErrorCodes.
+// The response body is restored so it can still be decoded by the clients.
+func conflictErrorCode(resp *http.Response) (string, bool) {
+	if resp.Body == nil {
+		return "", false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return "", false
+	}
+
+	var apiError struct {
+		ErrorCode string `json:"errorCode"`
+	}
+	if json.Unmarshal(body, &apiError) != nil {
+		return "", false
+	}
+
+	return apiError.ErrorCode, conflictRetryErrorCodes[apiError.ErrorCode]
+}
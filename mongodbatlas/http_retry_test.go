@@ -0,0 +1,85 @@
+package mongodbatlas
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConflictRetryTransport(t *testing.T) {
+	testCases := []struct {
+		name             string
+		errorCode        string
+		conflicts        int
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			name:             "change in progress is retried",
+			errorCode:        "CLUSTER_CHANGE_IN_PROGRESS",
+			conflicts:        2,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "other conflicts are not retried",
+			errorCode:        "DUPLICATE_DATABASE_NAME",
+			conflicts:        1,
+			expectedStatus:   http.StatusConflict,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "other changes in progress are not retried",
+			errorCode:        "BACKUP_RESTORE_IN_PROGRESS",
+			conflicts:        1,
+			expectedStatus:   http.StatusConflict,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if body, _ := io.ReadAll(r.Body); string(body) != `{"paused":true}` {
+					t.Errorf("attempt %d sent body %q", attempts, body)
+				}
+				if attempts <= tc.conflicts {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"errorCode":"` + tc.errorCode + `"}`))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &conflictRetryTransport{
+				transport:  http.DefaultTransport,
+				minBackoff: time.Millisecond,
+				maxBackoff: time.Millisecond,
+				maxElapsed: time.Second,
+			}}
+			req, err := http.NewRequest(http.MethodPatch, server.URL, strings.NewReader(`{"paused":true}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tc.expectedStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tc.expectedStatus)
+			}
+			if attempts != tc.expectedAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tc.expectedAttempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	importNameSeparator      = "/"
+	organizationProjectsPath = "api/atlas/v1.0/orgs/%s/groups"
+	importNamesItemsPerPage  = 500
+)
+
+// splitImportNames splits an import ID made of human-readable names, e.g. `org_name/project_name/cluster_name`,
+// returning false when the ID doesn't have exactly the expected number of non-empty names.
+func splitImportNames(id string, count int) ([]string, bool) {
+	if !strings.Contains(id, importNameSeparator) {
+		return nil, false
+	}
+
+	names := strings.Split(id, importNameSeparator)
+	if len(names) != count {
+		return nil, false
+	}
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			return nil, false
+		}
+	}
+
+	return names, true
+}
+
+// resolveOrganizationID returns the ID of the organization with the given name. The API filters organizations
+// by prefix, so the result is matched by the exact name.
+func resolveOrganizationID(ctx context.Context, conn *matlas.Client, orgName string) (string, error) {
+	options := &matlas.OrganizationsListOptions{
+		Name: orgName,
+		ListOptions: matlas.ListOptions{
+			ItemsPerPage: importNamesItemsPerPage,
+		},
+	}
+
+	var orgIDs []string
+	for page := 1; ; page++ {
+		options.PageNum = page
+		organizations, _, err := conn.Organizations.List(ctx, options)
+		if err != nil {
+			return "", fmt.Errorf("couldn't find organization %q: %s", orgName, err)
+		}
+
+		for _, org := range organizations.Results {
+			if org.Name == orgName {
+				orgIDs = append(orgIDs, org.ID)
+			}
+		}
+		if len(organizations.Results) < importNamesItemsPerPage {
+			break
+		}
+	}
+
+	switch len(orgIDs) {
+	case 0:
+		return "", fmt.Errorf("couldn't find organization %q", orgName)
+	case 1:
+		return orgIDs[0], nil
+	default:
+		return "", fmt.Errorf("found %d organizations named %q, import using the IDs instead", len(orgIDs), orgName)
+	}
+}
+
+// resolveProjectID returns the ID of the project with the given name in the organization with the given name.
+func resolveProjectID(ctx context.Context, conn *matlas.Client, orgName, projectName string) (string, error) {
+	orgID, err := resolveOrganizationID(ctx, conn, orgName)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("name", projectName)
+	query.Set("itemsPerPage", fmt.Sprint(importNamesItemsPerPage))
+
+	req, err := conn.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s?%s", fmt.Sprintf(organizationProjectsPath, orgID), query.Encode()), nil)
+	if err != nil {
+		return "", err
+	}
+
+	root := new(matlas.Projects)
+	if _, err = conn.Do(ctx, req, root); err != nil {
+		return "", fmt.Errorf("couldn't find project %q in organization %q: %s", projectName, orgName, err)
+	}
+
+	for _, project := range root.Results {
+		if project.Name == projectName {
+			return project.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("couldn't find project %q in organization %q", projectName, orgName)
+}
+
+// resolveTeamID returns the IDs of the organization and the team with the given names.
+func resolveTeamID(ctx context.Context, conn *matlas.Client, orgName, teamName string) (orgID, teamID string, err error) {
+	orgID, err = resolveOrganizationID(ctx, conn, orgName)
+	if err != nil {
+		return "", "", err
+	}
+
+	team, _, err := conn.Teams.GetOneTeamByName(ctx, orgID, teamName)
+	if err != nil {
+		return "", "", fmt.Errorf("couldn't find team %q in organization %q: %s", teamName, orgName, err)
+	}
+
+	return orgID, team.ID, nil
+}
+
+// resolveClusterImportID accepts both `{project_id}-{cluster_name}`, parsed by splitID, and
+// `{org_name}/{project_name}/{cluster_name}` import IDs.
+func resolveClusterImportID(ctx context.Context, conn *matlas.Client, id string, splitID func(string) (*string, *string, error)) (projectID, clusterName *string, err error) {
+	names, ok := splitImportNames(id, 3)
+	if !ok {
+		return splitID(id)
+	}
+
+	resolvedProjectID, err := resolveProjectID(ctx, conn, names[0], names[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &resolvedProjectID, &names[2], nil
+}
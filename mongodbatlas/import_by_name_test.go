@@ -0,0 +1,94 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestSplitImportNames(t *testing.T) {
+	testCases := []struct {
+		name     string
+		id       string
+		count    int
+		expected []string
+	}{
+		{
+			name:     "cluster names",
+			id:       "my org/my project/cluster0",
+			count:    3,
+			expected: []string{"my org", "my project", "cluster0"},
+		},
+		{
+			name:     "project names",
+			id:       "my org/my project",
+			count:    2,
+			expected: []string{"my org", "my project"},
+		},
+		{
+			name:  "ID based format",
+			id:    "5d0f1f73cf09a29120e173cf-cluster0",
+			count: 3,
+		},
+		{
+			name:  "missing name",
+			id:    "my org//cluster0",
+			count: 3,
+		},
+		{
+			name:  "too many names",
+			id:    "my org/my project/cluster0/extra",
+			count: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			names, ok := splitImportNames(tc.id, tc.count)
+			if ok != (tc.expected != nil) {
+				t.Fatalf("expected ok to be %t, got %t", tc.expected != nil, ok)
+			}
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Fatalf("expected %q, got %q", tc.expected, names)
+			}
+		})
+	}
+}
+
+// testAccImportByNamesStateIDFunc returns the `{org_name}/...` import ID of a resource, followed by the names found
+// in the given attributes. The organization is read from `org_id`, or from the project in `project_id`.
+func testAccImportByNamesStateIDFunc(resourceName string, attrs ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+		orgID := rs.Primary.Attributes["org_id"]
+		if orgID == "" {
+			project, _, err := conn.Projects.GetOneProject(context.Background(), rs.Primary.Attributes["project_id"])
+			if err != nil {
+				return "", err
+			}
+			orgID = project.OrgID
+		}
+
+		org, _, err := conn.Organizations.Get(context.Background(), orgID)
+		if err != nil {
+			return "", err
+		}
+
+		names := []string{org.Name}
+		for _, attr := range attrs {
+			names = append(names, rs.Primary.Attributes[attr])
+		}
+
+		return strings.Join(names, importNameSeparator), nil
+	}
+}
@@ -78,7 +78,7 @@ func NewSdkV2Provider() *schema.Provider {
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Region where secret is stored as part of AWS Secret Manager.",
+				Description: "Region where secret is stored as part of AWS Secret Manager. Required when `secret_name` is set.",
 			},
 			"sts_endpoint": {
 				Type:        schema.TypeString,
@@ -100,6 +100,28 @@ func NewSdkV2Provider() *schema.Provider {
 				Optional:    true,
 				Description: "AWS Security Token Service provided session token.",
 			},
+			"user_agent_suffix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Value appended to the User-Agent of every request sent to MongoDB Atlas, e.g. for partner attribution.",
+				ValidateFunc: validation.StringDoesNotContainAny("\r\n"),
+			},
+			"debug_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log the HTTP requests and responses sent to MongoDB Atlas with secrets redacted.",
+			},
+			"default_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Tags applied to every cluster managed by the provider. Tags set in the resource take precedence.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"prevent_external_deletion_recovery": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Fail the refresh of resources deleted outside of Terraform instead of removing them from the state with a warning.",
+			},
 		},
 		DataSourcesMap:       getDataSourcesMap(),
 		ResourcesMap:         getResourcesMap(),
@@ -117,13 +139,17 @@ func getDataSourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_custom_db_roles":                   dataSourceMongoDBAtlasCustomDBRoles(),
 		"mongodbatlas_api_key":                           dataSourceMongoDBAtlasAPIKey(),
 		"mongodbatlas_api_keys":                          dataSourceMongoDBAtlasAPIKeys(),
+		"mongodbatlas_access_logs":                       dataSourceMongoDBAtlasAccessLogs(),
+		"mongodbatlas_atlas_latest_versions":             dataSourceMongoDBAtlasLatestVersions(),
 		"mongodbatlas_access_list_api_key":               dataSourceMongoDBAtlasAccessListAPIKey(),
 		"mongodbatlas_access_list_api_keys":              dataSourceMongoDBAtlasAccessListAPIKeys(),
 		"mongodbatlas_project_api_key":                   dataSourceMongoDBAtlasProjectAPIKey(),
 		"mongodbatlas_project_api_keys":                  dataSourceMongoDBAtlasProjectAPIKeys(),
+		"mongodbatlas_project_export":                    dataSourceMongoDBAtlasProjectExport(),
 		"mongodbatlas_roles_org_id":                      dataSourceMongoDBAtlasOrgID(),
 		"mongodbatlas_cluster":                           dataSourceMongoDBAtlasCluster(),
 		"mongodbatlas_clusters":                          dataSourceMongoDBAtlasClusters(),
+		"mongodbatlas_clusters_summary":                  dataSourceMongoDBAtlasClustersSummary(),
 		"mongodbatlas_network_container":                 dataSourceMongoDBAtlasNetworkContainer(),
 		"mongodbatlas_network_containers":                dataSourceMongoDBAtlasNetworkContainers(),
 		"mongodbatlas_network_peering":                   dataSourceMongoDBAtlasNetworkPeering(),
@@ -146,6 +172,7 @@ func getDataSourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_third_party_integration":                                      dataSourceMongoDBAtlasThirdPartyIntegration(),
 		"mongodbatlas_cloud_provider_access":                                        dataSourceMongoDBAtlasCloudProviderAccessList(),
 		"mongodbatlas_cloud_provider_access_setup":                                  dataSourceMongoDBAtlasCloudProviderAccessSetup(),
+		"mongodbatlas_cloud_provider_regions":                                       dataSourceMongoDBAtlasCloudProviderRegions(),
 		"mongodbatlas_custom_dns_configuration_cluster_aws":                         dataSourceMongoDBAtlasCustomDNSConfigurationAWS(),
 		"mongodbatlas_online_archive":                                               dataSourceMongoDBAtlasOnlineArchive(),
 		"mongodbatlas_online_archives":                                              dataSourceMongoDBAtlasOnlineArchives(),
@@ -159,6 +186,7 @@ func getDataSourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_data_lake_pipelines":                                          dataSourceMongoDBAtlasDataLakePipelines(),
 		"mongodbatlas_event_trigger":                                                dataSourceMongoDBAtlasEventTrigger(),
 		"mongodbatlas_event_triggers":                                               dataSourceMongoDBAtlasEventTriggers(),
+		"mongodbatlas_events":                                                       dataSourceMongoDBAtlasEvents(),
 		"mongodbatlas_project_invitation":                                           dataSourceMongoDBAtlasProjectInvitation(),
 		"mongodbatlas_org_invitation":                                               dataSourceMongoDBAtlasOrgInvitation(),
 		"mongodbatlas_organization":                                                 dataSourceMongoDBAtlasOrganization(),
@@ -168,6 +196,7 @@ func getDataSourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_backup_compliance_policy":                                     dataSourceMongoDBAtlasBackupCompliancePolicy(),
 		"mongodbatlas_cloud_backup_snapshot_restore_job":                            dataSourceMongoDBAtlasCloudBackupSnapshotRestoreJob(),
 		"mongodbatlas_cloud_backup_snapshot_restore_jobs":                           dataSourceMongoDBAtlasCloudBackupSnapshotRestoreJobs(),
+		"mongodbatlas_restore_jobs":                                                 dataSourceMongoDBAtlasRestoreJobs(),
 		"mongodbatlas_cloud_backup_snapshot_export_bucket":                          datasourceMongoDBAtlasCloudBackupSnapshotExportBucket(),
 		"mongodbatlas_cloud_backup_snapshot_export_buckets":                         datasourceMongoDBAtlasCloudBackupSnapshotExportBuckets(),
 		"mongodbatlas_cloud_backup_snapshot_export_job":                             datasourceMongoDBAtlasCloudBackupSnapshotExportJob(),
@@ -196,21 +225,20 @@ func getDataSourcesMap() map[string]*schema.Resource {
 
 func getResourcesMap() map[string]*schema.Resource {
 	resourcesMap := map[string]*schema.Resource{
-		"mongodbatlas_advanced_cluster":                  resourceMongoDBAtlasAdvancedCluster(),
-		"mongodbatlas_api_key":                           resourceMongoDBAtlasAPIKey(),
-		"mongodbatlas_access_list_api_key":               resourceMongoDBAtlasAccessListAPIKey(),
-		"mongodbatlas_project_api_key":                   resourceMongoDBAtlasProjectAPIKey(),
-		"mongodbatlas_custom_db_role":                    resourceMongoDBAtlasCustomDBRole(),
-		"mongodbatlas_cluster":                           resourceMongoDBAtlasCluster(),
-		"mongodbatlas_network_container":                 resourceMongoDBAtlasNetworkContainer(),
-		"mongodbatlas_network_peering":                   resourceMongoDBAtlasNetworkPeering(),
-		"mongodbatlas_maintenance_window":                resourceMongoDBAtlasMaintenanceWindow(),
-		"mongodbatlas_auditing":                          resourceMongoDBAtlasAuditing(),
-		"mongodbatlas_team":                              resourceMongoDBAtlasTeam(),
-		"mongodbatlas_teams":                             resourceMongoDBAtlasTeam(),
-		"mongodbatlas_global_cluster_config":             resourceMongoDBAtlasGlobalCluster(),
-		"mongodbatlas_x509_authentication_database_user": resourceMongoDBAtlasX509AuthDBUser(),
-		"mongodbatlas_private_endpoint_regional_mode":    resourceMongoDBAtlasPrivateEndpointRegionalMode(),
+		"mongodbatlas_advanced_cluster":                                            resourceMongoDBAtlasAdvancedCluster(),
+		"mongodbatlas_api_key":                                                     resourceMongoDBAtlasAPIKey(),
+		"mongodbatlas_access_list_api_key":                                         resourceMongoDBAtlasAccessListAPIKey(),
+		"mongodbatlas_project_api_key":                                             resourceMongoDBAtlasProjectAPIKey(),
+		"mongodbatlas_custom_db_role":                                              resourceMongoDBAtlasCustomDBRole(),
+		"mongodbatlas_cluster":                                                     resourceMongoDBAtlasCluster(),
+		"mongodbatlas_network_container":                                           resourceMongoDBAtlasNetworkContainer(),
+		"mongodbatlas_network_peering":                                             resourceMongoDBAtlasNetworkPeering(),
+		"mongodbatlas_maintenance_window":                                          resourceMongoDBAtlasMaintenanceWindow(),
+		"mongodbatlas_maintenance_window_deferral":                                 resourceMongoDBAtlasMaintenanceWindowDeferral(),
+		"mongodbatlas_auditing":                                                    resourceMongoDBAtlasAuditing(),
+		"mongodbatlas_global_cluster_config":                                       resourceMongoDBAtlasGlobalCluster(),
+		"mongodbatlas_x509_authentication_database_user":                           resourceMongoDBAtlasX509AuthDBUser(),
+		"mongodbatlas_private_endpoint_regional_mode":                              resourceMongoDBAtlasPrivateEndpointRegionalMode(),
 		"mongodbatlas_privatelink_endpoint_service_data_federation_online_archive": resourceMongoDBAtlasPrivatelinkEndpointServiceDataFederationOnlineArchive(),
 		"mongodbatlas_privatelink_endpoint":                                        resourceMongoDBAtlasPrivateLinkEndpoint(),
 		"mongodbatlas_privatelink_endpoint_serverless":                             resourceMongoDBAtlasPrivateLinkEndpointServerless(),
@@ -231,6 +259,7 @@ func getResourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_project_invitation":                                          resourceMongoDBAtlasProjectInvitation(),
 		"mongodbatlas_org_invitation":                                              resourceMongoDBAtlasOrgInvitation(),
 		"mongodbatlas_organization":                                                resourceMongoDBAtlasOrganization(),
+		"mongodbatlas_org_settings":                                                resourceMongoDBAtlasOrgSettings(),
 		"mongodbatlas_cloud_backup_snapshot":                                       resourceMongoDBAtlasCloudBackupSnapshot(),
 		"mongodbatlas_backup_compliance_policy":                                    resourceMongoDBAtlasBackupCompliancePolicy(),
 		"mongodbatlas_cloud_backup_snapshot_restore_job":                           resourceMongoDBAtlasCloudBackupSnapshotRestoreJob(),
@@ -243,6 +272,7 @@ func getResourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_federated_query_limit":                                       resourceMongoDBAtlasFederatedDatabaseQueryLimit(),
 		"mongodbatlas_serverless_instance":                                         resourceMongoDBAtlasServerlessInstance(),
 		"mongodbatlas_cluster_outage_simulation":                                   resourceMongoDBAtlasClusterOutageSimulation(),
+		"mongodbatlas_streams_processor":                                           resourceMongoDBAtlasStreamsProcessor(),
 	}
 	return resourcesMap
 }
@@ -256,20 +286,30 @@ func addBetaFeatures(provider *schema.Provider) {
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	assumeRoleValue, ok := d.GetOk("assume_role")
 	awsRoleDefined := ok && len(assumeRoleValue.([]interface{})) > 0 && assumeRoleValue.([]interface{})[0] != nil
-	diagnostics := setDefaultsAndValidations(d, awsRoleDefined)
+	awsSecretDefined := awsRoleDefined || d.Get("secret_name").(string) != ""
+	diagnostics := setDefaultsAndValidations(d, awsSecretDefined)
 	if diagnostics.HasError() {
 		return nil, diagnostics
 	}
 
 	config := Config{
-		PublicKey:    d.Get("public_key").(string),
-		PrivateKey:   d.Get("private_key").(string),
-		BaseURL:      d.Get("base_url").(string),
-		RealmBaseURL: d.Get("realm_base_url").(string),
+		PublicKey:       d.Get("public_key").(string),
+		PrivateKey:      d.Get("private_key").(string),
+		BaseURL:         d.Get("base_url").(string),
+		RealmBaseURL:    d.Get("realm_base_url").(string),
+		UserAgentSuffix: d.Get("user_agent_suffix").(string),
+		DebugLogging:    d.Get("debug_logging").(bool),
+		DefaultTags:     cast.ToStringMapString(d.Get("default_tags")),
+
+		PreventExternalDeletionRecovery: d.Get("prevent_external_deletion_recovery").(bool),
+		AWSRegion:                       d.Get("region").(string),
 	}
 
 	if awsRoleDefined {
 		config.AssumeRole = expandAssumeRole(assumeRoleValue.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if awsSecretDefined {
 		secret := d.Get("secret_name").(string)
 		region := d.Get("region").(string)
 		awsAccessKeyID := d.Get("aws_access_key_id").(string)
@@ -290,7 +330,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	return client, diagnostics
 }
 
-func setDefaultsAndValidations(d *schema.ResourceData, awsRoleDefined bool) diag.Diagnostics {
+func setDefaultsAndValidations(d *schema.ResourceData, awsSecretDefined bool) diag.Diagnostics {
 	diagnostics := []diag.Diagnostic{}
 
 	mongodbgovCloud := pointy.Bool(d.Get("is_mongodbgov_cloud").(bool))
@@ -313,7 +353,7 @@ func setDefaultsAndValidations(d *schema.ResourceData, awsRoleDefined bool) diag
 	}); err != nil {
 		return append(diagnostics, diag.FromErr(err)...)
 	}
-	if d.Get("public_key").(string) == "" && !awsRoleDefined {
+	if d.Get("public_key").(string) == "" && !awsSecretDefined {
 		diagnostics = append(diagnostics, diag.Diagnostic{Severity: diag.Warning, Summary: MissingAuthAttrError})
 	}
 
@@ -324,7 +364,7 @@ func setDefaultsAndValidations(d *schema.ResourceData, awsRoleDefined bool) diag
 		return append(diagnostics, diag.FromErr(err)...)
 	}
 
-	if d.Get("private_key").(string) == "" && !awsRoleDefined {
+	if d.Get("private_key").(string) == "" && !awsSecretDefined {
 		diagnostics = append(diagnostics, diag.Diagnostic{Severity: diag.Warning, Summary: MissingAuthAttrError})
 	}
 
@@ -369,6 +409,10 @@ func setDefaultsAndValidations(d *schema.ResourceData, awsRoleDefined bool) diag
 		return append(diagnostics, diag.FromErr(err)...)
 	}
 
+	if awsSecretDefined && d.Get("region").(string) == "" {
+		return append(diagnostics, diag.Diagnostic{Severity: diag.Error, Summary: MissingSecretRegionError})
+	}
+
 	return diagnostics
 }
 
@@ -389,6 +433,10 @@ func MultiEnvDefaultFunc(ks []string, def interface{}) interface{} {
 	return def
 }
 
+// configureCredentialsSTS fetches the Atlas programmatic API keys stored in AWS Secrets Manager.
+// AWS credentials are taken from the provider configuration when present, otherwise the AWS default
+// credential chain (environment, shared config, instance profile) is used. When an assume role is
+// configured, the secret is read with the credentials of the assumed role.
 func configureCredentialsSTS(config Config, secret, region, awsAccessKeyID, awsSecretAccessKey, awsSessionToken, endpoint string) (Config, error) {
 	ep, err := endpoints.GetSTSRegionalEndpoint("regional")
 	if err != nil {
@@ -416,26 +464,34 @@ func configureCredentialsSTS(config Config, secret, region, awsAccessKeyID, awsS
 
 	cfg := aws.Config{
 		Region:              aws.String(region),
-		Credentials:         credentials.NewStaticCredentials(awsAccessKeyID, awsSecretAccessKey, awsSessionToken),
 		STSRegionalEndpoint: ep,
 		EndpointResolver:    endpoints.ResolverFunc(stsCustResolverFn),
 	}
 
-	sess := session.Must(session.NewSession(&cfg))
+	if awsAccessKeyID != "" || awsSecretAccessKey != "" {
+		cfg.Credentials = credentials.NewStaticCredentials(awsAccessKeyID, awsSecretAccessKey, awsSessionToken)
+	}
 
-	creds := stscreds.NewCredentials(sess, config.AssumeRole.RoleARN)
+	sess := session.Must(session.NewSession(&cfg))
 
 	_, err = sess.Config.Credentials.Get()
 	if err != nil {
 		log.Printf("Session get credentials error: %s", err)
 		return config, err
 	}
-	_, err = creds.Get()
-	if err != nil {
-		log.Printf("STS get credentials error: %s", err)
-		return config, err
+
+	secretsConfig := &aws.Config{Region: aws.String(region)}
+	if config.AssumeRole != nil && config.AssumeRole.RoleARN != "" {
+		creds := stscreds.NewCredentials(sess, config.AssumeRole.RoleARN)
+		_, err = creds.Get()
+		if err != nil {
+			log.Printf("STS get credentials error: %s", err)
+			return config, err
+		}
+		secretsConfig.Credentials = creds
 	}
-	secretString, err := secretsManagerGetSecretValue(sess, &aws.Config{Credentials: creds, Region: aws.String(region)}, secret)
+
+	secretString, err := secretsManagerGetSecretValue(sess, secretsConfig, secret)
 	if err != nil {
 		log.Printf("Get Secrets error: %s", err)
 		return config, err
@@ -564,6 +620,15 @@ func expandStringList(list []interface{}) (res []string) {
 	return
 }
 
+func expandStringListFromSetSchema(list *schema.Set) []string {
+	res := make([]string, list.Len())
+	for i, v := range list.List() {
+		res[i] = v.(string)
+	}
+
+	return res
+}
+
 func getEncodedID(stateID, keyPosition string) string {
 	id := ""
 	if !hasMultipleValues(stateID) {
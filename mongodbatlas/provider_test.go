@@ -2,6 +2,10 @@ package mongodbatlas
 
 import (
 	"context"
+	"flag"
+	"log"
+	"math/rand"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
@@ -9,12 +13,15 @@ import (
 	"github.com/go-test/deep"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 const (
 	// Provider name for single configuration testing
 	ProviderNameMongoDBAtlas = "mongodbatlas"
+
+	httpRecorderTestSeed = 1
 )
 
 var testAccProviderV6Factories map[string]func() (tfprotov6.ProviderServer, error)
@@ -27,6 +34,10 @@ var testAccProviderSdkV2 *schema.Provider
 var testMongoDBClient interface{}
 
 func init() {
+	if isHTTPRecorderEnabled() {
+		setupHTTPRecorderForTests()
+	}
+
 	testAccProviderSdkV2 = NewSdkV2Provider()
 
 	testAccProviderV6Factories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -44,6 +55,66 @@ func init() {
 	testMongoDBClient, _ = config.NewClient(context.Background())
 }
 
+// TestMain runs the sweepers when the tests are run with -sweep, e.g. `make sweep`, and the tests otherwise. The HTTP
+// cassette is saved once every test ran, when the HTTP recorder is enabled in record mode.
+func TestMain(m *testing.M) {
+	resource.TestMain(&testRunner{m: m})
+}
+
+type testRunner struct {
+	m *testing.M
+}
+
+func (r *testRunner) Run() int {
+	// the random names are drawn from the global source, so parallel tests would draw them in a different order in
+	// each run and the replayed requests wouldn't match the recorded ones
+	if isHTTPRecorderEnabled() {
+		if err := flag.Set("test.parallel", "1"); err != nil {
+			log.Printf("[ERROR] error disabling the parallel tests for the HTTP recorder: %s", err)
+		}
+	}
+
+	code := r.m.Run()
+	if err := saveHTTPCassette(); err != nil {
+		log.Printf("[ERROR] error saving the HTTP cassette: %s", err)
+		code = 1
+	}
+	return code
+}
+
+// setupHTTPRecorderForTests makes the acceptance tests repeatable when they're recorded or replayed. The random names
+// of the resources are generated with a fixed seed, so the tests send the same requests in both modes as long as the
+// same tests are run, and the replayed tests get the environment variables of the recording. The credentials aren't
+// recorded, so dummy ones are set when replaying without them.
+func setupHTTPRecorderForTests() {
+	recorder, err := getHTTPRecorder()
+	if err != nil {
+		log.Fatalf("[ERROR] error loading the HTTP cassette: %s", err)
+	}
+
+	// the recorder wraps the digest transport, so the authentication challenges are neither recorded nor replayed
+	testTransportWrapper = func(transport http.RoundTripper) http.RoundTripper {
+		return &httpRecorderTransport{transport: transport, recorder: recorder}
+	}
+
+	rand.Seed(httpRecorderTestSeed) //nolint:staticcheck // acctest generates the random names with the global source
+
+	if err = applyHTTPCassetteEnv(); err != nil {
+		log.Fatalf("[ERROR] error loading the HTTP cassette: %s", err)
+	}
+	if recorder.mode != httpRecorderModeReplay {
+		return
+	}
+	for _, name := range []string{"MONGODB_ATLAS_PUBLIC_KEY", "MONGODB_ATLAS_PRIVATE_KEY"} {
+		if os.Getenv(name) != "" {
+			continue
+		}
+		if err := os.Setenv(name, "replay"); err != nil {
+			log.Printf("[ERROR] error setting %s: %s", name, err)
+		}
+	}
+}
+
 func TestSdkV2Provider(t *testing.T) {
 	if err := NewSdkV2Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -129,8 +129,7 @@ func resourceMongoDBAtlasAccessListAPIKeyRead(ctx context.Context, d *schema.Res
 	apiKey, resp, err := conn.AccessListAPIKeys.Get(ctx, orgID, apiKeyID, strings.ReplaceAll(ids["entry"], "/", "%2F"))
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_access_list_api_key")
 		}
 		return diag.FromErr(fmt.Errorf("error getting api key information: %s", err))
 	}
@@ -9,12 +9,15 @@ import (
 	"net/http"
 	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 	"golang.org/x/exp/slices"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,6 +37,10 @@ const (
 	errorAdvancedClusterAdvancedConfUpdate = "error updating Advanced Configuration Option form MongoDB Cluster (%s): %s"
 	errorAdvancedClusterAdvancedConfRead   = "error reading Advanced Configuration Option form MongoDB Cluster (%s): %s"
 	errorAdvancedClusterListStatus         = "error awaiting MongoDB ClusterAdvanced List IDLE: %s"
+	errorClusterAdvancedCreateWait         = "error creating MongoDB ClusterAdvanced (%s), the cluster was in state %s when waiting stopped: %s"
+	// the cluster settings the matlas client doesn't support are only in the 2024-08-05 version of the clusters API
+	advancedClusterV2Path         = "api/atlas/v2/groups/%s/clusters"
+	advancedClusterV2ItemsPerPage = 500
 )
 
 var upgradeRequestCtxKey acCtxKey = "upgradeRequest"
@@ -47,6 +54,7 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasAdvancedClusterImportState,
 		},
+		CustomizeDiff: resourceAdvancedClusterCustomizeDiff,
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -75,6 +83,11 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 				Optional:    true,
 				Description: "Flag that indicates whether to retain backup snapshots for the deleted dedicated cluster",
 			},
+			"delete_on_create_timeout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Flag that indicates whether to delete the cluster if its creation times out or fails",
+			},
 			"bi_connector_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -99,6 +112,16 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"config_server_management_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ATLAS_MANAGED", "FIXED_TO_DEDICATED"}, false),
+			},
+			"config_server_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"connection_strings": clusterConnectionStringsSchema(),
 			"create_date": {
 				Type:     schema.TypeString,
@@ -135,7 +158,8 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 					},
 				},
 			},
-			"tags": &tagsSchema,
+			"tags":     &tagsSchema,
+			"tags_all": &tagsAllSchema,
 			"mongo_db_major_version": {
 				Type:      schema.TypeString,
 				Optional:  true,
@@ -289,10 +313,46 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 				},
 				// Set: replicationSpecsHashSet,
 			},
-			"root_cert_type": {
-				Type:     schema.TypeString,
+			"replica_set_scaling_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SEQUENTIAL", "WORKLOAD_TYPE", "NODE_TYPE"}, false),
+			},
+			"mongo_db_employee_access": {
+				Type:     schema.TypeList,
 				Optional: true,
-				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"CLUSTER_DATABASE_LOGS",
+								"CLUSTER_INFRASTRUCTURE",
+								"CLUSTER_INFRASTRUCTURE_AND_APP_SERVICES_SYNC_DATA",
+							}, false),
+						},
+						"expiration_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							// Atlas may return the timestamp in another format, e.g. with milliseconds
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								oldTime, oldErr := time.Parse(time.RFC3339, old)
+								newTime, newErr := time.Parse(time.RFC3339, new)
+								return oldErr == nil && newErr == nil && oldTime.Equal(newTime)
+							},
+						},
+					},
+				},
+			},
+			"root_cert_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ISRGROOTX1"}, false),
 			},
 			"state_name": {
 				Type:     schema.TypeString,
@@ -319,23 +379,56 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 	}
 }
 
+const (
+	tagsMaxItems = 50
+	tagMaxLength = 255
+)
+
 var tagsSchema = schema.Schema{
 	Type:     schema.TypeSet,
 	Optional: true,
+	MaxItems: tagsMaxItems,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringLenBetween(1, tagMaxLength), validateTagKey),
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, tagMaxLength),
+			},
+		},
+	},
+}
+
+// tagsAllSchema holds the tags applied to the resource, including the provider default_tags.
+var tagsAllSchema = schema.Schema{
+	Type:     schema.TypeSet,
+	Computed: true,
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"key": {
 				Type:     schema.TypeString,
-				Required: true,
+				Computed: true,
 			},
 			"value": {
 				Type:     schema.TypeString,
-				Required: true,
+				Computed: true,
 			},
 		},
 	},
 }
 
+func validateTagKey(v interface{}, k string) (warnings []string, errs []error) {
+	if strings.EqualFold(v.(string), defaultLabel.Key) {
+		errs = append(errs, fmt.Errorf("%q can't be %q, it is used for internal purposes", k, defaultLabel.Key))
+	}
+	return warnings, errs
+}
+
 func HashFunctionForKeyValuePair(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -360,8 +453,9 @@ func advancedClusterRegionConfigsSpecsSchema() *schema.Schema {
 					Optional: true,
 				},
 				"instance_size": {
-					Type:     schema.TypeString,
-					Required: true,
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressAutoScaledInstanceSize,
 				},
 				"node_count": {
 					Type:     schema.TypeInt,
@@ -406,8 +500,8 @@ func resourceMongoDBAtlasAdvancedClusterCreate(ctx context.Context, d *schema.Re
 	}
 	request.Labels = append(expandLabelSliceFromSetSchema(d), defaultLabel)
 
-	if _, ok := d.GetOk("tags"); ok {
-		request.Tags = expandTagSliceFromSetSchema(d)
+	if tags := mergeTags(meta.(*MongoDBClient).Config.DefaultTags, d.Get("tags").(*schema.Set)); len(tags) > 0 {
+		request.Tags = tags
 	}
 
 	if v, ok := d.GetOk("mongo_db_major_version"); ok {
@@ -452,7 +546,19 @@ func resourceMongoDBAtlasAdvancedClusterCreate(ctx context.Context, d *schema.Re
 	// Wait, catching any errors
 	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorClusterAdvancedCreate, err))
+		return advancedClusterCreateWaitFailed(ctx, conn, d, projectID, cluster, err)
+	}
+
+	if settings := newAdvancedClusterSettings(d, false); settings != nil {
+		if err = updateAdvancedClusterSettings(ctx, conn, settings, projectID, cluster.Name, timeout); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedUpdate, cluster.Name, err))
+		}
+	}
+
+	if grant := expandMongoDBEmployeeAccessGrant(d.Get("mongo_db_employee_access").([]interface{})); grant != nil {
+		if err = updateMongoDBEmployeeAccessGrant(ctx, conn, grant, projectID, cluster.Name); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedUpdate, cluster.Name, err))
+		}
 	}
 
 	/*
@@ -502,8 +608,7 @@ func resourceMongoDBAtlasAdvancedClusterRead(ctx context.Context, d *schema.Reso
 	cluster, resp, err := conn.AdvancedClusters.Get(ctx, projectID, clusterName)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_advanced_cluster")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedRead, clusterName, err))
@@ -547,10 +652,15 @@ func resourceMongoDBAtlasAdvancedClusterRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "labels", clusterName, err))
 	}
 
-	if err := d.Set("tags", flattenTags(&cluster.Tags)); err != nil {
+	tags := removeDefaultTags(cluster.Tags, meta.(*MongoDBClient).Config.DefaultTags, d.Get("tags").(*schema.Set))
+	if err := d.Set("tags", flattenTags(&tags)); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "tags", clusterName, err))
 	}
 
+	if err := d.Set("tags_all", flattenTags(&cluster.Tags)); err != nil {
+		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "tags_all", clusterName, err))
+	}
+
 	if err := d.Set("mongo_db_major_version", cluster.MongoDBMajorVersion); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "mongo_db_major_version", clusterName, err))
 	}
@@ -576,6 +686,33 @@ func resourceMongoDBAtlasAdvancedClusterRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "replication_specs", clusterName, err))
 	}
 
+	if hasAdvancedClusterSettings(d) {
+		settings, err := getAdvancedClusterSettings(ctx, conn, projectID, clusterName)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedRead, clusterName, err))
+		}
+
+		if err := d.Set("replica_set_scaling_strategy", settings.ReplicaSetScalingStrategy); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "replica_set_scaling_strategy", clusterName, err))
+		}
+
+		if err := d.Set("config_server_management_mode", settings.ConfigServerManagementMode); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "config_server_management_mode", clusterName, err))
+		}
+
+		if err := d.Set("config_server_type", settings.ConfigServerType); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "config_server_type", clusterName, err))
+		}
+
+		grant := settings.MongoDBEmployeeAccessGrant
+		if grant == nil {
+			grant = expiredMongoDBEmployeeAccessGrant(d)
+		}
+		if err := d.Set("mongo_db_employee_access", flattenMongoDBEmployeeAccessGrant(grant)); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "mongo_db_employee_access", clusterName, err))
+		}
+	}
+
 	if err := d.Set("replication_specs", replicationSpecs); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "replication_specs", clusterName, err))
 	}
@@ -685,11 +822,12 @@ func resourceMongoDBAtlasAdvancedClusterUpdate(ctx context.Context, d *schema.Re
 		cluster.Labels = append(expandLabelSliceFromSetSchema(d), defaultLabel)
 	}
 
-	if d.HasChange("tags") {
-		cluster.Tags = expandTagSliceFromSetSchema(d)
+	if d.HasChange("tags") || d.HasChange("tags_all") {
+		cluster.Tags = mergeTags(meta.(*MongoDBClient).Config.DefaultTags, d.Get("tags").(*schema.Set))
 	}
 
-	if d.HasChange("mongo_db_major_version") {
+	// Atlas picks the major version of clusters on the continuous release system.
+	if d.HasChange("mongo_db_major_version") && d.Get("version_release_system").(string) != "CONTINUOUS" {
 		cluster.MongoDBMajorVersion = formatMongoDBMajorVersion(d.Get("mongo_db_major_version"))
 	}
 
@@ -730,6 +868,9 @@ func resourceMongoDBAtlasAdvancedClusterUpdate(ctx context.Context, d *schema.Re
 				}
 			}
 		}
+		if err := resetOplogMinRetentionHours(ctx, d, conn, projectID, clusterName); err != nil {
+			return diag.FromErr(fmt.Errorf(errorAdvancedClusterAdvancedConfUpdate, clusterName, err))
+		}
 	}
 
 	// Has changes
@@ -758,6 +899,19 @@ func resourceMongoDBAtlasAdvancedClusterUpdate(ctx context.Context, d *schema.Re
 		}
 	}
 
+	if settings := newAdvancedClusterSettings(d, true); settings != nil {
+		if err := updateAdvancedClusterSettings(ctx, conn, settings, projectID, clusterName, timeout); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedUpdate, clusterName, err))
+		}
+	}
+
+	if d.HasChange("mongo_db_employee_access") {
+		grant := expandMongoDBEmployeeAccessGrant(d.Get("mongo_db_employee_access").([]interface{}))
+		if err := updateMongoDBEmployeeAccessGrant(ctx, conn, grant, projectID, clusterName); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedUpdate, clusterName, err))
+		}
+	}
+
 	if d.Get("paused").(bool) {
 		clusterRequest := &matlas.AdvancedCluster{
 			Paused: pointy.Bool(true),
@@ -779,6 +933,10 @@ func resourceMongoDBAtlasAdvancedClusterDelete(ctx context.Context, d *schema.Re
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
+	if d.Get("termination_protection_enabled").(bool) {
+		return diag.Errorf(errorTerminationProtectionEnabled, "MongoDB ClusterAdvanced", clusterName)
+	}
+
 	var options *matlas.DeleteAdvanceClusterOptions
 	if v, ok := d.GetOkExists("retain_backups_enabled"); ok {
 		options = &matlas.DeleteAdvanceClusterOptions{
@@ -786,9 +944,17 @@ func resourceMongoDBAtlasAdvancedClusterDelete(ctx context.Context, d *schema.Re
 		}
 	}
 
+	if err := deleteAdvancedClusterAndWait(ctx, conn, projectID, clusterName, options, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf(errorClusterAdvancedDelete, clusterName, err))
+	}
+
+	return nil
+}
+
+func deleteAdvancedClusterAndWait(ctx context.Context, conn *matlas.Client, projectID, clusterName string, options *matlas.DeleteAdvanceClusterOptions, timeout time.Duration) error {
 	_, err := conn.AdvancedClusters.Delete(ctx, projectID, clusterName, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorClusterAdvancedDelete, clusterName, err))
+		return err
 	}
 
 	log.Println("[INFO] Waiting for MongoDB ClusterAdvanced to be destroyed")
@@ -797,24 +963,63 @@ func resourceMongoDBAtlasAdvancedClusterDelete(ctx context.Context, d *schema.Re
 		Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
 		Target:     []string{"DELETED"},
 		Refresh:    resourceClusterAdvancedRefreshFunc(ctx, clusterName, projectID, conn),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    timeout,
 		MinTimeout: 30 * time.Second,
 		Delay:      1 * time.Minute, // Wait 30 secs before starting
 	}
 
 	// Wait, catching any errors
 	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorClusterAdvancedDelete, clusterName, err))
+	return err
+}
+
+// advancedClusterCreateWaitFailed reports the state of a cluster that didn't become IDLE within the create timeout.
+// With delete_on_create_timeout the partially created cluster is deleted, otherwise it is kept in the state as tainted
+// so the next apply replaces it instead of failing with DUPLICATE_CLUSTER_NAME.
+func advancedClusterCreateWaitFailed(ctx context.Context, conn *matlas.Client, d *schema.ResourceData, projectID string, cluster *matlas.AdvancedCluster, waitErr error) diag.Diagnostics {
+	stateName := "UNKNOWN"
+	if current, _, err := conn.AdvancedClusters.Get(ctx, projectID, cluster.Name); err == nil && current.StateName != "" {
+		stateName = current.StateName
+	}
+	diags := diag.Errorf(errorClusterAdvancedCreateWait, cluster.Name, stateName, waitErr)
+
+	if !d.Get("delete_on_create_timeout").(bool) {
+		d.SetId(encodeStateID(map[string]string{
+			"cluster_id":   cluster.ID,
+			"project_id":   projectID,
+			"cluster_name": cluster.Name,
+		}))
+		return diags
+	}
+
+	if d.Get("termination_protection_enabled").(bool) {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Cluster not deleted after a failed creation",
+			Detail:   fmt.Sprintf("delete_on_create_timeout is ignored because termination protection is enabled, cluster %s must be deleted manually", cluster.Name),
+		})
 	}
 
-	return nil
+	log.Printf("[INFO] Deleting MongoDB ClusterAdvanced (%s) because its creation failed", cluster.Name)
+	if err := deleteAdvancedClusterAndWait(ctx, conn, projectID, cluster.Name, nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Error deleting cluster after a failed creation",
+			Detail:   fmt.Sprintf(errorClusterAdvancedDelete, cluster.Name, err),
+		})
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Cluster deleted after a failed creation",
+		Detail:   fmt.Sprintf("cluster %s was deleted because delete_on_create_timeout is true", cluster.Name),
+	})
 }
 
 func resourceMongoDBAtlasAdvancedClusterImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*MongoDBClient).Atlas
 
-	projectID, name, err := splitSClusterAdvancedImportID(d.Id())
+	projectID, name, err := resolveClusterImportID(ctx, conn, d.Id(), splitSClusterAdvancedImportID)
 	if err != nil {
 		return nil, err
 	}
@@ -846,7 +1051,7 @@ func splitSClusterAdvancedImportID(id string) (projectID, clusterName *string, e
 	parts := re.FindStringSubmatch(id)
 
 	if len(parts) != 3 {
-		err = errors.New("import format error: to import a advanced cluster, use the format {project_id}-{name}")
+		err = errors.New("import format error: to import a advanced cluster, use the format {project_id}-{name} or {org_name}/{project_name}/{name}")
 		return
 	}
 
@@ -1457,6 +1662,91 @@ func expandTagSliceFromSetSchema(d *schema.ResourceData) []*matlas.Tag {
 	return res
 }
 
+// mergeTags returns the provider default tags overridden by the resource tags, sorted by key.
+func mergeTags(defaultTags map[string]string, tags *schema.Set) []*matlas.Tag {
+	merged := make(map[string]string, len(defaultTags))
+	for k, v := range defaultTags {
+		merged[k] = v
+	}
+	for _, val := range tags.List() {
+		v := val.(map[string]interface{})
+		merged[v["key"].(string)] = v["value"].(string)
+	}
+
+	keys := make([]string, 0, len(merged))
+	for k := range merged {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([]*matlas.Tag, len(keys))
+	for i, k := range keys {
+		res[i] = &matlas.Tag{Key: k, Value: merged[k]}
+	}
+	return res
+}
+
+// removeDefaultTags returns the tags set in Atlas without the provider default tags, unless the resource also
+// configures them, so default_tags don't show up as drift in the resource tags.
+func removeDefaultTags(tags []*matlas.Tag, defaultTags map[string]string, configuredTags *schema.Set) []*matlas.Tag {
+	configured := map[string]bool{}
+	for _, val := range configuredTags.List() {
+		configured[val.(map[string]interface{})["key"].(string)] = true
+	}
+
+	res := make([]*matlas.Tag, 0, len(tags))
+	for _, tag := range tags {
+		if defaultValue, ok := defaultTags[tag.Key]; ok && defaultValue == tag.Value && !configured[tag.Key] {
+			continue
+		}
+		res = append(res, tag)
+	}
+	return res
+}
+
+// customizeDiffTags plans tags_all from the resource tags and the provider default_tags, and checks the limits
+// that only apply once both are merged.
+func customizeDiffTags(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	merged := mergeTags(meta.(*MongoDBClient).Config.DefaultTags, d.Get("tags").(*schema.Set))
+	if len(merged) > tagsMaxItems {
+		return fmt.Errorf("a cluster can have at most %d tags including the provider default_tags, got %d", tagsMaxItems, len(merged))
+	}
+
+	if labels, ok := d.Get("labels").(*schema.Set); ok {
+		for _, val := range labels.List() {
+			key := val.(map[string]interface{})["key"].(string)
+			for _, tag := range merged {
+				if tag.Key == key {
+					return fmt.Errorf("%q is set both as a label and as a tag, remove it from `labels` as labels are deprecated in favor of tags", key)
+				}
+			}
+		}
+	}
+
+	current := sortedTagsFromSet(d.Get("tags_all").(*schema.Set))
+	if reflect.DeepEqual(flattenTags(&merged), flattenTags(&current)) {
+		return nil
+	}
+	return d.SetNew("tags_all", flattenTags(&merged))
+}
+
+func sortedTagsFromSet(list *schema.Set) []*matlas.Tag {
+	res := make([]*matlas.Tag, 0, list.Len())
+	for _, val := range list.List() {
+		v := val.(map[string]interface{})
+		res = append(res, &matlas.Tag{
+			Key:   v["key"].(string),
+			Value: v["value"].(string),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res
+}
+
 func containsLabelOrKey(list []matlas.Label, item matlas.Label) bool {
 	for _, v := range list {
 		if reflect.DeepEqual(v, item) || v.Key == item.Key {
@@ -1485,3 +1775,417 @@ func removeLabel(list []matlas.Label, item matlas.Label) []matlas.Label {
 
 	return list
 }
+
+// resourceAdvancedClusterCustomizeDiff keeps root_cert_type and version_release_system as in-place updates,
+// reconciles mongo_db_major_version with the release system so switching it doesn't leave a stale plan, rejects the
+// storage settings the cloud provider of a region doesn't support, the read-only and analytics nodes Atlas can't
+// place and config server settings for replica sets, and plans tags_all from the provider default_tags.
+func resourceAdvancedClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if d.Get("version_release_system").(string) == "CONTINUOUS" && rawConfig.IsKnown() && !rawConfig.IsNull() {
+		if !rawConfig.GetAttr("mongo_db_major_version").IsNull() {
+			return fmt.Errorf("`mongo_db_major_version` can't be set when `version_release_system` is CONTINUOUS")
+		}
+	}
+
+	if err := validateAdvancedClusterStorage(d.Get("replication_specs").([]interface{})); err != nil {
+		return err
+	}
+
+	if err := validateAdvancedClusterNodePlacement(rawConfig); err != nil {
+		return err
+	}
+
+	if rawConfig.IsKnown() && !rawConfig.IsNull() && !rawConfig.GetAttr("config_server_management_mode").IsNull() {
+		if clusterType := d.Get("cluster_type").(string); clusterType != "SHARDED" && clusterType != "GEOSHARDED" {
+			return fmt.Errorf("`config_server_management_mode` can only be set for SHARDED and GEOSHARDED clusters, not for %s", clusterType)
+		}
+	}
+
+	// continuous clusters follow the latest release, so their major version is only known once Atlas switches them
+	if d.HasChange("version_release_system") && d.Get("version_release_system").(string) == "CONTINUOUS" && d.Id() != "" {
+		if err := d.SetNewComputed("mongo_db_major_version"); err != nil {
+			return err
+		}
+	}
+
+	return customizeDiffTags(d, meta)
+}
+
+// suppressAutoScaledInstanceSize ignores the instance size of the electable and read-only nodes while auto_scaling
+// scales them, and the one of the analytics nodes while analytics_auto_scaling does, as long as the configured size is
+// within both the auto-scaling range Atlas scaled the tier in and the configured one. Updating the cluster then doesn't
+// bring a tier back to the configured size, while a size outside of either range is still applied.
+func suppressAutoScaledInstanceSize(k, old, newValue string, d *schema.ResourceData) bool {
+	parts := strings.Split(k, ".")
+	if old == "" || len(parts) < 4 {
+		return false
+	}
+
+	autoScaling := "auto_scaling"
+	if parts[len(parts)-3] == "analytics_specs" {
+		autoScaling = "analytics_auto_scaling"
+	}
+	autoScalingPath := fmt.Sprintf("%s.%s.0", strings.Join(parts[:len(parts)-3], "."), autoScaling)
+
+	oldEnabled, newEnabled := d.GetChange(autoScalingPath + ".compute_enabled")
+	oldMin, newMin := d.GetChange(autoScalingPath + ".compute_min_instance_size")
+	oldMax, newMax := d.GetChange(autoScalingPath + ".compute_max_instance_size")
+
+	return isInstanceSizeAutoScaled(newValue,
+		computeAutoScaling{enabled: oldEnabled.(bool), minInstanceSize: oldMin.(string), maxInstanceSize: oldMax.(string)},
+		computeAutoScaling{enabled: newEnabled.(bool), minInstanceSize: newMin.(string), maxInstanceSize: newMax.(string)})
+}
+
+type computeAutoScaling struct {
+	minInstanceSize string
+	maxInstanceSize string
+	enabled         bool
+}
+
+// isInstanceSizeAutoScaled returns whether the configured instance size may differ from the one Atlas scaled the tier
+// to, i.e. whether compute auto-scaling is enabled and the size is within both the previous and the configured range.
+func isInstanceSizeAutoScaled(instanceSize string, previous, configured computeAutoScaling) bool {
+	return previous.enabled && configured.enabled && previous.inRange(instanceSize) && configured.inRange(instanceSize)
+}
+
+// inRange returns whether the instance size is within the auto-scaling range. The minimum is optional.
+func (c computeAutoScaling) inRange(instanceSize string) bool {
+	size, ok := instanceSizeNumber(instanceSize)
+	if !ok {
+		return false
+	}
+
+	maxSize, ok := instanceSizeNumber(c.maxInstanceSize)
+	if !ok || size > maxSize {
+		return false
+	}
+
+	minSize, ok := instanceSizeNumber(c.minInstanceSize)
+	return !ok || size >= minSize
+}
+
+// instanceSizeNumber returns the number of an instance size, e.g. 40 for M40, R40 or M40_NVME, used to order them.
+func instanceSizeNumber(instanceSize string) (int, bool) {
+	if len(instanceSize) < 2 {
+		return 0, false
+	}
+
+	digits, _, _ := strings.Cut(instanceSize[1:], "_")
+	number, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
+
+// validateAdvancedClusterNodePlacement fails the plan when read-only or analytics nodes are placed where Atlas rejects
+// them. It uses the configuration, as auto_scaling and analytics_auto_scaling are computed for every region config.
+func validateAdvancedClusterNodePlacement(rawConfig cty.Value) error {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return nil
+	}
+
+	replicationSpecs := rawConfig.GetAttr("replication_specs")
+	if !replicationSpecs.IsKnown() || replicationSpecs.IsNull() {
+		return nil
+	}
+
+	for it := replicationSpecs.ElementIterator(); it.Next(); {
+		_, replicationSpec := it.Element()
+		if !replicationSpec.IsKnown() || replicationSpec.IsNull() {
+			continue
+		}
+
+		regionConfigs := replicationSpec.GetAttr("region_configs")
+		if !regionConfigs.IsKnown() || regionConfigs.IsNull() {
+			continue
+		}
+
+		for regionIt := regionConfigs.ElementIterator(); regionIt.Next(); {
+			_, regionConfig := regionIt.Element()
+			if !regionConfig.IsKnown() || regionConfig.IsNull() {
+				continue
+			}
+			if err := validateAdvancedClusterRegionConfigNodes(regionConfig); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateAdvancedClusterRegionConfigNodes(regionConfig cty.Value) error {
+	providerName := regionConfig.GetAttr("provider_name")
+	regionName := regionConfig.GetAttr("region_name")
+	if !providerName.IsKnown() || providerName.IsNull() || !regionName.IsKnown() || regionName.IsNull() {
+		return nil
+	}
+	region := fmt.Sprintf("%s %s", providerName.AsString(), regionName.AsString())
+
+	if providerName.AsString() == "TENANT" {
+		for _, attribute := range []string{"read_only_specs", "analytics_specs", "analytics_auto_scaling"} {
+			if hasConfigBlock(regionConfig.GetAttr(attribute)) {
+				return fmt.Errorf("`%s` can't be set for the %s region config, shared-tier clusters only have electable nodes", attribute, region)
+			}
+		}
+		return nil
+	}
+
+	if hasConfigBlock(regionConfig.GetAttr("analytics_auto_scaling")) && !hasConfigBlock(regionConfig.GetAttr("analytics_specs")) {
+		return fmt.Errorf("`analytics_auto_scaling` requires `analytics_specs` in the %s region config", region)
+	}
+
+	electableNodes, known := configBlockNodeCount(regionConfig.GetAttr("electable_specs"))
+	priority := regionConfig.GetAttr("priority")
+	if !known || !priority.IsKnown() || priority.IsNull() || electableNodes > 0 {
+		return nil
+	}
+
+	readOnlyNodes, readOnlyKnown := configBlockNodeCount(regionConfig.GetAttr("read_only_specs"))
+	analyticsNodes, analyticsKnown := configBlockNodeCount(regionConfig.GetAttr("analytics_specs"))
+	if readOnlyKnown && analyticsKnown && readOnlyNodes+analyticsNodes == 0 {
+		return fmt.Errorf("the %s region config has no nodes, set `electable_specs`, `read_only_specs` or `analytics_specs`", region)
+	}
+	if p, _ := priority.AsBigFloat().Int64(); p != 0 {
+		return fmt.Errorf("`priority` must be 0 for the %s region config, as it only has read-only or analytics nodes", region)
+	}
+
+	return nil
+}
+
+func hasConfigBlock(block cty.Value) bool {
+	return block.IsKnown() && !block.IsNull() && block.LengthInt() > 0
+}
+
+// configBlockNodeCount returns the node_count of a specs block, 0 when the block isn't set, and whether it's known.
+// Atlas deploys nodes for a specs block without node_count, so it counts as one.
+func configBlockNodeCount(block cty.Value) (count int64, known bool) {
+	if !block.IsKnown() {
+		return 0, false
+	}
+	if block.IsNull() || block.LengthInt() == 0 {
+		return 0, true
+	}
+
+	specs := block.Index(cty.NumberIntVal(0))
+	if !specs.IsKnown() || specs.IsNull() {
+		return 0, false
+	}
+
+	nodeCount := specs.GetAttr("node_count")
+	if !nodeCount.IsKnown() {
+		return 0, false
+	}
+	if nodeCount.IsNull() {
+		return 1, true
+	}
+
+	count, _ = nodeCount.AsBigFloat().Int64()
+	return count, true
+}
+
+// advancedClusterSettings holds the cluster settings the client doesn't support yet: how Atlas scales the nodes of the
+// replica sets, how it manages the config servers of sharded clusters and the access granted to MongoDB employees.
+type advancedClusterSettings struct {
+	MongoDBEmployeeAccessGrant *mongoDBEmployeeAccessGrant `json:"mongoDBEmployeeAccessGrant,omitempty"`
+	Name                       string                      `json:"name,omitempty"`
+	ReplicaSetScalingStrategy  string                      `json:"replicaSetScalingStrategy,omitempty"`
+	ConfigServerManagementMode string                      `json:"configServerManagementMode,omitempty"`
+	ConfigServerType           string                      `json:"configServerType,omitempty"`
+}
+
+// mongoDBEmployeeAccessGrant is the level of access MongoDB employees have to the cluster until the expiration time.
+type mongoDBEmployeeAccessGrant struct {
+	GrantType      string `json:"grantType"`
+	ExpirationTime string `json:"expirationTime"`
+}
+
+type advancedClusterSettingsList struct {
+	Results []*advancedClusterSettings `json:"results"`
+}
+
+var advancedClusterSpecsAttributes = []string{"analytics_specs", "electable_specs", "read_only_specs"}
+
+// validateAdvancedClusterStorage fails the plan when a region config uses storage settings its cloud provider doesn't
+// support, instead of waiting for Atlas to reject the cluster.
+func validateAdvancedClusterStorage(replicationSpecs []interface{}) error {
+	for _, replicationSpec := range replicationSpecs {
+		replicationSpecMap, ok := replicationSpec.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		regionConfigs, _ := replicationSpecMap["region_configs"].([]interface{})
+		for _, regionConfig := range regionConfigs {
+			regionConfigMap, ok := regionConfig.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			providerName := regionConfigMap["provider_name"].(string)
+
+			for _, attribute := range advancedClusterSpecsAttributes {
+				specs := firstMapOfList(regionConfigMap[attribute])
+				if specs == nil {
+					continue
+				}
+				if specs["ebs_volume_type"].(string) != "" && providerName != "AWS" {
+					return fmt.Errorf("`%s.ebs_volume_type` can only be set for AWS, not for %s", attribute, providerName)
+				}
+				if specs["disk_iops"].(int) > 0 && providerName != "AWS" {
+					return fmt.Errorf("`%s.disk_iops` can only be set for AWS, not for %s", attribute, providerName)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// newAdvancedClusterSettings returns the configured cluster settings, or only the changed ones when updating, or nil
+// when there's none to send.
+func newAdvancedClusterSettings(d *schema.ResourceData, onlyChanges bool) *advancedClusterSettings {
+	settings := &advancedClusterSettings{}
+	if v, ok := d.GetOk("replica_set_scaling_strategy"); ok && (!onlyChanges || d.HasChange("replica_set_scaling_strategy")) {
+		settings.ReplicaSetScalingStrategy = v.(string)
+	}
+	if v, ok := d.GetOk("config_server_management_mode"); ok && (!onlyChanges || d.HasChange("config_server_management_mode")) {
+		settings.ConfigServerManagementMode = v.(string)
+	}
+
+	if settings.ReplicaSetScalingStrategy == "" && settings.ConfigServerManagementMode == "" {
+		return nil
+	}
+
+	return settings
+}
+
+// updateAdvancedClusterSettings sends only the settings, as the 2024-08-05 clusters API describes the replication specs
+// differently than the client, and waits for the cluster to apply them.
+func updateAdvancedClusterSettings(ctx context.Context, conn *matlas.Client, settings *advancedClusterSettings, projectID, name string, timeout time.Duration) error {
+	path := fmt.Sprintf(advancedClusterV2Path+"/%s", projectID, name)
+	if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodPatch, path, atlasAPIV2Version20240805, settings, nil); err != nil {
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"CREATING", "UPDATING", "REPAIRING"},
+		Target:     []string{"IDLE"},
+		Refresh:    resourceClusterAdvancedRefreshFunc(ctx, name, projectID, conn),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// hasAdvancedClusterSettings reports whether the configuration or the state uses any of the cluster settings, so reads
+// only request them from the 2024-08-05 clusters API when there's something to refresh.
+func hasAdvancedClusterSettings(d *schema.ResourceData) bool {
+	for _, attribute := range []string{"replica_set_scaling_strategy", "config_server_management_mode", "config_server_type", "mongo_db_employee_access"} {
+		if _, ok := d.GetOk(attribute); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getAdvancedClusterSettings(ctx context.Context, conn *matlas.Client, projectID, clusterName string) (*advancedClusterSettings, error) {
+	path := fmt.Sprintf(advancedClusterV2Path+"/%s", projectID, clusterName)
+	settings := new(advancedClusterSettings)
+	if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodGet, path, atlasAPIV2Version20240805, nil, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// listAdvancedClusterSettings returns the settings of every cluster of the project by cluster name.
+func listAdvancedClusterSettings(ctx context.Context, conn *matlas.Client, projectID string) (map[string]*advancedClusterSettings, error) {
+	settings := map[string]*advancedClusterSettings{}
+	for page := 1; ; page++ {
+		path := fmt.Sprintf(advancedClusterV2Path+"?pageNum=%d&itemsPerPage=%d", projectID, page, advancedClusterV2ItemsPerPage)
+		root := new(advancedClusterSettingsList)
+		if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodGet, path, atlasAPIV2Version20240805, nil, root); err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range root.Results {
+			settings[cluster.Name] = cluster
+		}
+
+		if len(root.Results) < advancedClusterV2ItemsPerPage {
+			return settings, nil
+		}
+	}
+}
+
+func expandMongoDBEmployeeAccessGrant(tfList []interface{}) *mongoDBEmployeeAccessGrant {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	return &mongoDBEmployeeAccessGrant{
+		GrantType:      tfMap["grant_type"].(string),
+		ExpirationTime: tfMap["expiration_time"].(string),
+	}
+}
+
+func flattenMongoDBEmployeeAccessGrant(grant *mongoDBEmployeeAccessGrant) []map[string]interface{} {
+	if grant == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"grant_type":      grant.GrantType,
+			"expiration_time": grant.ExpirationTime,
+		},
+	}
+}
+
+// expiredMongoDBEmployeeAccessGrant returns the grant in the state if it has expired. Atlas stops returning a grant once
+// it expires, so keeping it avoids a diff that would never go away while the configuration still holds it.
+func expiredMongoDBEmployeeAccessGrant(d *schema.ResourceData) *mongoDBEmployeeAccessGrant {
+	grant := expandMongoDBEmployeeAccessGrant(d.Get("mongo_db_employee_access").([]interface{}))
+	if grant == nil {
+		return nil
+	}
+
+	expirationTime, err := time.Parse(time.RFC3339, grant.ExpirationTime)
+	if err != nil || expirationTime.After(time.Now()) {
+		return nil
+	}
+	return grant
+}
+
+// updateMongoDBEmployeeAccessGrant grants MongoDB employees access to the cluster, replacing any previous grant, or
+// revokes the access when grant is nil.
+func updateMongoDBEmployeeAccessGrant(ctx context.Context, conn *matlas.Client, grant *mongoDBEmployeeAccessGrant, projectID, name string) error {
+	// the revoke request has no body, so a nil grant must not be sent as a JSON null
+	var body interface{}
+	path := fmt.Sprintf(advancedClusterV2Path+"/%s:revokeMongoDBEmployeeAccess", projectID, name)
+	if grant != nil {
+		body = grant
+		path = fmt.Sprintf(advancedClusterV2Path+"/%s:grantMongoDBEmployeeAccess", projectID, name)
+	}
+
+	_, err := doAtlasAPIV2Request(ctx, conn, http.MethodPost, path, atlasAPIV2Version20240805, body, nil)
+	return err
+}
+
+func firstMapOfList(tfList interface{}) map[string]interface{} {
+	list, ok := tfList.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil
+	}
+
+	tfMap, _ := list[0].(map[string]interface{})
+	return tfMap
+}
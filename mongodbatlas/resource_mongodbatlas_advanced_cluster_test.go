@@ -2,18 +2,32 @@ package mongodbatlas
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/mwielbut/pointy"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+func init() {
+	resource.AddTestSweepers("mongodbatlas_advanced_cluster", &resource.Sweeper{
+		Name: "mongodbatlas_advanced_cluster",
+		F:    testSweepAdvancedClusters,
+	})
+}
+
 func TestAccClusterAdvancedCluster_basicTenant(t *testing.T) {
 	var (
 		cluster                matlas.AdvancedCluster
@@ -534,6 +548,40 @@ func TestAccClusterAdvancedClusterConfig_ReplicationSpecsAutoScaling(t *testing.
 	})
 }
 
+func TestAccClusterAdvancedClusterConfig_ReplicationSpecsAutoScalingResize(t *testing.T) {
+	var (
+		cluster      matlas.AdvancedCluster
+		resourceName = "mongodbatlas_advanced_cluster.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		rName        = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigAutoScalingResize(orgID, projectName, rName, "M10", "M10", "M20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.electable_specs.0.instance_size", "M10"),
+				),
+			},
+			{
+				// the new size is outside of the range Atlas scaled the cluster in, so it's applied
+				Config: testAccMongoDBAtlasAdvancedClusterConfigAutoScalingResize(orgID, projectName, rName, "M30", "M30", "M40"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.electable_specs.0.instance_size", "M30"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.auto_scaling.0.compute_min_instance_size", "M30"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccClusterAdvancedClusterConfig_ReplicationSpecsAnalyticsAutoScaling(t *testing.T) {
 	var (
 		cluster      matlas.AdvancedCluster
@@ -673,6 +721,95 @@ var tagsMap3 = map[string]string{
 	"value": "value 3",
 }
 
+func TestAccClusterAdvancedCluster_VersionReleaseSystem(t *testing.T) {
+	var (
+		cluster      matlas.AdvancedCluster
+		resourceName = "mongodbatlas_advanced_cluster.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		rName        = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigVersionReleaseSystem(orgID, projectName, rName, "LTS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "version_release_system", "LTS"),
+					resource.TestCheckResourceAttr(resourceName, "root_cert_type", "ISRGROOTX1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigVersionReleaseSystem(orgID, projectName, rName, "CONTINUOUS"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "version_release_system", "CONTINUOUS"),
+					resource.TestCheckResourceAttrSet(resourceName, "mongo_db_major_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterAdvancedCluster_TerminationProtection(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_advanced_cluster.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		rName        = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigTerminationProtection(orgID, projectName, rName, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "termination_protection_enabled", "true"),
+			},
+			{
+				Config:      testAccMongoDBAtlasAdvancedClusterConfigTerminationProtection(orgID, projectName, rName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("has termination protection enabled"),
+			},
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigTerminationProtection(orgID, projectName, rName, false),
+				Check:  resource.TestCheckResourceAttr(resourceName, "termination_protection_enabled", "false"),
+			},
+		},
+	})
+}
+
+func TestAccClusterAdvancedCluster_DeleteOnCreateTimeout(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc")
+		rName       = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasAdvancedClusterConfigDeleteOnCreateTimeout(orgID, projectName, rName),
+				ExpectError: regexp.MustCompile("when waiting stopped"),
+			},
+		},
+	})
+}
+
 func testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName string, cluster *matlas.AdvancedCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
@@ -783,6 +920,184 @@ data "mongodbatlas_advanced_clusters" "test" {
 	`, orgID, projectName, name)
 }
 
+func TestAccClusterAdvancedCluster_WithDefaultTags(t *testing.T) {
+	var (
+		cluster      matlas.AdvancedCluster
+		resourceName = "mongodbatlas_advanced_cluster.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		rName        = acctest.RandomWithPrefix("test-acc")
+		defaultTags  = `
+			provider "mongodbatlas" {
+				default_tags = {
+					"environment" = "test"
+					"team"        = "default"
+				}
+			}
+		`
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: defaultTags + testAccMongoDBAtlasAdvancedClusterConfigWithTags(orgID, projectName, rName, []matlas.Tag{
+					{
+						Key:   "team",
+						Value: "override",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tags.*", map[string]string{"key": "team", "value": "override"}),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tags_all.*", map[string]string{"key": "environment", "value": "test"}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tags_all.*", map[string]string{"key": "team", "value": "override"}),
+				),
+			},
+			{
+				Config: defaultTags + testAccMongoDBAtlasAdvancedClusterConfigWithTags(orgID, projectName, rName, []matlas.Tag{}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tags_all.*", map[string]string{"key": "team", "value": "default"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterAdvancedCluster_WithInternalTagKey(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc")
+		rName       = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigWithTags(orgID, projectName, rName, []matlas.Tag{
+					{
+						Key:   "Infrastructure Tool",
+						Value: "value",
+					},
+				}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("it is used for internal purposes"),
+			},
+		},
+	})
+}
+
+func TestAccClusterAdvancedCluster_StorageValidation(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc")
+		rName       = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasAdvancedClusterConfigStorage(orgID, projectName, rName, "GCP", "US_EAST_4", "disk_iops = 3000"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`electable_specs.disk_iops` can only be set for AWS, not for GCP"),
+			},
+			{
+				Config:      testAccMongoDBAtlasAdvancedClusterConfigStorage(orgID, projectName, rName, "AZURE", "US_EAST_2", "ebs_volume_type = \"PROVISIONED\""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`electable_specs.ebs_volume_type` can only be set for AWS, not for AZURE"),
+			},
+		},
+	})
+}
+
+func TestAccClusterAdvancedCluster_NodePlacementValidation(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc")
+		rName       = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigNodePlacement(orgID, projectName, rName, `
+					read_only_specs {
+						instance_size = "M10"
+						node_count    = 1
+					}
+					priority = 6`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`priority` must be 0 for the AWS US_WEST_2 region config"),
+			},
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigNodePlacement(orgID, projectName, rName, `
+					read_only_specs {
+						instance_size = "M10"
+						node_count    = 1
+					}
+					analytics_auto_scaling {
+						compute_enabled = true
+					}
+					priority = 0`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`analytics_auto_scaling` requires `analytics_specs` in the AWS US_WEST_2 region config"),
+			},
+		},
+	})
+}
+
+func TestAccClusterAdvancedCluster_ScalingStrategyAndConfigServer(t *testing.T) {
+	var (
+		cluster      matlas.AdvancedCluster
+		resourceName = "mongodbatlas_advanced_cluster.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		rName        = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasAdvancedClusterConfigScalingStrategy(orgID, projectName, rName, "REPLICASET", "SEQUENTIAL", "ATLAS_MANAGED"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`config_server_management_mode` can only be set for SHARDED and GEOSHARDED clusters, not for REPLICASET"),
+			},
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigScalingStrategy(orgID, projectName, rName, "SHARDED", "WORKLOAD_TYPE", "FIXED_TO_DEDICATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "replica_set_scaling_strategy", "WORKLOAD_TYPE"),
+					resource.TestCheckResourceAttr(resourceName, "config_server_management_mode", "FIXED_TO_DEDICATED"),
+					resource.TestCheckResourceAttr(resourceName, "config_server_type", "DEDICATED"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigScalingStrategy(orgID, projectName, rName, "SHARDED", "NODE_TYPE", "FIXED_TO_DEDICATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "replica_set_scaling_strategy", "NODE_TYPE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccMongoDBAtlasAdvancedClusterConfigWithTags(orgID, projectName, name string, tags []matlas.Tag) string {
 	var tagsConf string
 	for _, label := range tags {
@@ -1124,6 +1439,39 @@ resource "mongodbatlas_advanced_cluster" "test" {
 	`, orgID, projectName, name, *p.Compute.Enabled, *p.DiskGBEnabled, p.Compute.MaxInstanceSize)
 }
 
+func testAccMongoDBAtlasAdvancedClusterConfigAutoScalingResize(orgID, projectName, name, instanceSize, minInstanceSize, maxInstanceSize string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id   = mongodbatlas_project.cluster_project.id
+  name         = %[3]q
+  cluster_type = "REPLICASET"
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = %[4]q
+        node_count    = 3
+      }
+      auto_scaling {
+        compute_enabled            = true
+        compute_scale_down_enabled = true
+        compute_min_instance_size  = %[5]q
+        compute_max_instance_size  = %[6]q
+      }
+      provider_name = "AWS"
+      priority      = 7
+      region_name   = "US_EAST_1"
+    }
+  }
+}
+	`, orgID, projectName, name, instanceSize, minInstanceSize, maxInstanceSize)
+}
+
 func testAccMongoDBAtlasAdvancedClusterConfigReplicationSpecsAnalyticsAutoScaling(orgID, projectName, name string, p *matlas.AutoScaling) string {
 	return fmt.Sprintf(`
 
@@ -1163,3 +1511,360 @@ resource "mongodbatlas_advanced_cluster" "test" {
 
 	`, orgID, projectName, name, *p.Compute.Enabled, *p.DiskGBEnabled, p.Compute.MaxInstanceSize)
 }
+
+func testAccMongoDBAtlasAdvancedClusterConfigVersionReleaseSystem(orgID, projectName, name, versionReleaseSystem string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id             = mongodbatlas_project.cluster_project.id
+  name                   = %[3]q
+  cluster_type           = "REPLICASET"
+  root_cert_type         = "ISRGROOTX1"
+  version_release_system = %[4]q
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = "M10"
+        node_count    = 3
+      }
+      provider_name = "AWS"
+      priority      = 7
+      region_name   = "US_EAST_1"
+    }
+  }
+}
+	`, orgID, projectName, name, versionReleaseSystem)
+}
+
+func testAccMongoDBAtlasAdvancedClusterConfigTerminationProtection(orgID, projectName, name string, terminationProtection bool) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id                     = mongodbatlas_project.cluster_project.id
+  name                           = %[3]q
+  cluster_type                   = "REPLICASET"
+  termination_protection_enabled = %[4]t
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = "M10"
+        node_count    = 3
+      }
+      provider_name = "AWS"
+      priority      = 7
+      region_name   = "US_EAST_1"
+    }
+  }
+}
+	`, orgID, projectName, name, terminationProtection)
+}
+
+func testAccMongoDBAtlasAdvancedClusterConfigDeleteOnCreateTimeout(orgID, projectName, name string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id               = mongodbatlas_project.cluster_project.id
+  name                     = %[3]q
+  cluster_type             = "REPLICASET"
+  delete_on_create_timeout = true
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = "M10"
+        node_count    = 3
+      }
+      provider_name = "AWS"
+      priority      = 7
+      region_name   = "US_EAST_1"
+    }
+  }
+
+  timeouts {
+    create = "1m"
+  }
+}
+	`, orgID, projectName, name)
+}
+
+// testSweepAdvancedClusters deletes the clusters of the projects created by the acceptance tests, and the clusters
+// the tests created in the shared project. Clusters of any type are listed, so it sweeps mongodbatlas_cluster too.
+func testSweepAdvancedClusters(_ string) error {
+	client, orgID, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	conn := client.Atlas
+	projectIDs, err := sweepableProjectIDs(ctx, conn, orgID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for projectID, sweepAll := range projectIDs {
+		clusters, _, err := conn.AdvancedClusters.List(ctx, projectID, &matlas.ListOptions{ItemsPerPage: sweeperItemsPerPage})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error listing the clusters of project %s: %s", projectID, err))
+			continue
+		}
+
+		for _, cluster := range clusters.Results {
+			if (!sweepAll && !isSweepable(cluster.Name)) || cluster.StateName == "DELETING" {
+				continue
+			}
+			if cluster.TerminationProtectionEnabled != nil && *cluster.TerminationProtectionEnabled {
+				log.Printf("[WARN] skipping cluster %s of project %s, its termination protection is enabled", cluster.Name, projectID)
+				continue
+			}
+
+			log.Printf("[INFO] sweeping cluster %s of project %s", cluster.Name, projectID)
+			if _, err := conn.AdvancedClusters.Delete(ctx, projectID, cluster.Name, nil); err != nil {
+				errs = append(errs, fmt.Errorf("error deleting cluster %s of project %s: %s", cluster.Name, projectID, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func testAccMongoDBAtlasAdvancedClusterConfigStorage(orgID, projectName, name, providerName, regionName, specs string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id   = mongodbatlas_project.cluster_project.id
+  name         = %[3]q
+  cluster_type = "REPLICASET"
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = "M10"
+        node_count    = 3
+        %[6]s
+      }
+      provider_name = %[4]q
+      priority      = 7
+      region_name   = %[5]q
+    }
+  }
+}
+	`, orgID, projectName, name, providerName, regionName, specs)
+}
+
+func testAccMongoDBAtlasAdvancedClusterConfigNodePlacement(orgID, projectName, name, regionConfig string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id   = mongodbatlas_project.cluster_project.id
+  name         = %[3]q
+  cluster_type = "REPLICASET"
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = "M10"
+        node_count    = 3
+      }
+      provider_name = "AWS"
+      priority      = 7
+      region_name   = "US_EAST_1"
+    }
+    region_configs {
+      %[4]s
+      provider_name = "AWS"
+      region_name   = "US_WEST_2"
+    }
+  }
+}
+	`, orgID, projectName, name, regionConfig)
+}
+
+func TestIsInstanceSizeAutoScaled(t *testing.T) {
+	autoScaling := computeAutoScaling{enabled: true, minInstanceSize: "M10", maxInstanceSize: "M40"}
+
+	testCases := []struct {
+		name         string
+		instanceSize string
+		previous     computeAutoScaling
+		configured   computeAutoScaling
+		expected     bool
+	}{
+		{
+			name:         "within the range",
+			instanceSize: "M10",
+			previous:     autoScaling,
+			configured:   autoScaling,
+			expected:     true,
+		},
+		{
+			name:         "resize above the maximum",
+			instanceSize: "M60",
+			previous:     autoScaling,
+			configured:   autoScaling,
+			expected:     false,
+		},
+		{
+			name:         "resize below the minimum",
+			instanceSize: "M5",
+			previous:     autoScaling,
+			configured:   autoScaling,
+			expected:     false,
+		},
+		{
+			name:         "resize along with the range",
+			instanceSize: "M50",
+			previous:     autoScaling,
+			configured:   computeAutoScaling{enabled: true, minInstanceSize: "M50", maxInstanceSize: "M60"},
+			expected:     false,
+		},
+		{
+			name:         "without minimum",
+			instanceSize: "R20",
+			previous:     computeAutoScaling{enabled: true, maxInstanceSize: "R40"},
+			configured:   computeAutoScaling{enabled: true, maxInstanceSize: "R40"},
+			expected:     true,
+		},
+		{
+			name:         "auto-scaling disabled",
+			instanceSize: "M10",
+			previous:     autoScaling,
+			configured:   computeAutoScaling{minInstanceSize: "M10", maxInstanceSize: "M40"},
+			expected:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isInstanceSizeAutoScaled(tc.instanceSize, tc.previous, tc.configured); got != tc.expected {
+				t.Errorf("isInstanceSizeAutoScaled() = %t, want %t", got, tc.expected)
+			}
+		})
+	}
+}
+
+func testAccMongoDBAtlasAdvancedClusterConfigScalingStrategy(orgID, projectName, name, clusterType, scalingStrategy, configServerManagementMode string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+
+resource "mongodbatlas_advanced_cluster" "test" {
+  project_id                    = mongodbatlas_project.cluster_project.id
+  name                          = %[3]q
+  cluster_type                  = %[4]q
+  replica_set_scaling_strategy  = %[5]q
+  config_server_management_mode = %[6]q
+
+  replication_specs {
+    region_configs {
+      electable_specs {
+        instance_size = "M30"
+        node_count    = 3
+      }
+      provider_name = "AWS"
+      priority      = 7
+      region_name   = "US_EAST_1"
+    }
+  }
+}
+	`, orgID, projectName, name, clusterType, scalingStrategy, configServerManagementMode)
+}
+
+func TestAdvancedClusterSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if accept, want := r.Header.Get("Accept"), fmt.Sprintf(atlasAPIV2AcceptHeaderFmt, atlasAPIV2Version20240805); accept != want {
+			t.Errorf("Accept = %q, want %q", accept, want)
+		}
+		switch r.URL.Path {
+		case "/api/atlas/v2/groups/project/clusters/sharded":
+			_, _ = w.Write([]byte(`{"name":"sharded","replicaSetScalingStrategy":"NODE_TYPE","configServerManagementMode":"FIXED_TO_DEDICATED","configServerType":"DEDICATED"}`))
+		case "/api/atlas/v2/groups/project/clusters":
+			_, _ = w.Write([]byte(`{"results":[{"name":"sharded","configServerType":"EMBEDDED"},{"name":"replicaset","replicaSetScalingStrategy":"WORKLOAD_TYPE"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conn, err := matlas.New(http.DefaultClient, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := getAdvancedClusterSettings(context.Background(), conn, "project", "sharded")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := advancedClusterSettings{
+		Name:                       "sharded",
+		ReplicaSetScalingStrategy:  "NODE_TYPE",
+		ConfigServerManagementMode: "FIXED_TO_DEDICATED",
+		ConfigServerType:           "DEDICATED",
+	}
+	if *settings != expected {
+		t.Errorf("getAdvancedClusterSettings() = %+v, want %+v", *settings, expected)
+	}
+
+	list, err := listAdvancedClusterSettings(context.Background(), conn, "project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list["sharded"].ConfigServerType != "EMBEDDED" || list["replicaset"].ReplicaSetScalingStrategy != "WORKLOAD_TYPE" {
+		t.Errorf("listAdvancedClusterSettings() = %+v", list)
+	}
+}
+
+func TestUpdateMongoDBEmployeeAccessGrant(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(body)))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	conn, err := matlas.New(http.DefaultClient, matlas.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grant := expandMongoDBEmployeeAccessGrant([]interface{}{
+		map[string]interface{}{"grant_type": "CLUSTER_DATABASE_LOGS", "expiration_time": "2025-01-01T00:00:00Z"},
+	})
+	if err = updateMongoDBEmployeeAccessGrant(context.Background(), conn, grant, "project", "cluster"); err != nil {
+		t.Fatal(err)
+	}
+	if err = updateMongoDBEmployeeAccessGrant(context.Background(), conn, nil, "project", "cluster"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		`POST /api/atlas/v2/groups/project/clusters/cluster:grantMongoDBEmployeeAccess {"grantType":"CLUSTER_DATABASE_LOGS","expirationTime":"2025-01-01T00:00:00Z"}`,
+		"POST /api/atlas/v2/groups/project/clusters/cluster:revokeMongoDBEmployeeAccess",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("requests = %q, want %q", requests, expected)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
 
@@ -96,9 +95,7 @@ func resourceMongoDBAtlasAPIKeyRead(ctx context.Context, d *schema.ResourceData,
 	apiKey, resp, err := conn.APIKeys.Get(ctx, orgID, apiKeyID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
-			log.Printf("warning API key deleted will recreate: %s \n", err.Error())
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_api_key")
 		}
 		return diag.FromErr(fmt.Errorf("error getting api key information: %s", err))
 	}
@@ -308,8 +308,7 @@ func resourceMongoDBAtlasBackupCompliancePolicyRead(ctx context.Context, d *sche
 	backupPolicy, resp, err := conn.BackupCompliancePolicy.Get(context.Background(), projectID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_backup_compliance_policy")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorBackupPolicyRead, projectID, err))
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mwielbut/pointy"
 	"github.com/spf13/cast"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
@@ -25,6 +27,16 @@ const (
 	snapshotScheduleMonthly            = "monthly"
 )
 
+var (
+	snapshotRetentionUnits = []string{"days", "weeks", "months"}
+	// snapshotRetentionUnitDays rounds months up so valid policies are never rejected.
+	snapshotRetentionUnitDays = map[string]int{
+		"days":   1,
+		"weeks":  7,
+		"months": 31,
+	}
+)
+
 // https://docs.atlas.mongodb.com/reference/api/cloud-backup/schedule/modify-one-schedule/
 // same as resourceMongoDBAtlasCloudProviderSnapshotBackupPolicy
 func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
@@ -36,6 +48,7 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasCloudBackupScheduleImportState,
 		},
+		CustomizeDiff: resourceCloudBackupScheduleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -132,16 +145,19 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 							Computed: true,
 						},
 						"frequency_interval": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 2, 4, 6, 8, 12}),
 						},
 						"retention_value": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 						"retention_unit": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(snapshotRetentionUnits, false),
 						},
 					},
 				},
@@ -161,16 +177,19 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 							Computed: true,
 						},
 						"frequency_interval": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{1}),
 						},
 						"retention_unit": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(snapshotRetentionUnits, false),
 						},
 						"retention_value": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -189,16 +208,19 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 							Computed: true,
 						},
 						"frequency_interval": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
 						},
 						"retention_unit": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(snapshotRetentionUnits, false),
 						},
 						"retention_value": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -217,16 +239,19 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 							Computed: true,
 						},
 						"frequency_interval": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.Any(validation.IntBetween(1, 28), validation.IntInSlice([]int{40})),
 						},
 						"retention_unit": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(snapshotRetentionUnits, false),
 						},
 						"retention_value": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -279,6 +304,41 @@ func resourceMongoDBAtlasCloudBackupSchedule() *schema.Resource {
 	}
 }
 
+// resourceCloudBackupScheduleCustomizeDiff checks that less frequent policy items retain their snapshots at
+// least as long as the more frequent ones, which Atlas otherwise rejects with an opaque error on apply.
+func resourceCloudBackupScheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	maxRetentionDays, maxRetentionAttr := 0, ""
+	for _, frequencyType := range []string{snapshotScheduleHourly, snapshotScheduleDaily, snapshotScheduleWeekly, snapshotScheduleMonthly} {
+		attr := fmt.Sprintf("policy_item_%s", frequencyType)
+		items, _ := d.Get(attr).([]interface{})
+
+		typeMaxRetentionDays, typeMaxRetentionAttr := maxRetentionDays, maxRetentionAttr
+		for i, item := range items {
+			itemObj, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			unitDays, ok := snapshotRetentionUnitDays[itemObj["retention_unit"].(string)]
+			if !ok || itemObj["retention_value"].(int) == 0 {
+				continue
+			}
+			retentionDays := itemObj["retention_value"].(int) * unitDays
+			itemAttr := fmt.Sprintf("%s.%d", attr, i)
+
+			if retentionDays < maxRetentionDays {
+				return fmt.Errorf("%s.retention_value: the retention of a %s policy item (%d %s) must be greater than or equal to the retention of %s",
+					itemAttr, frequencyType, itemObj["retention_value"].(int), itemObj["retention_unit"].(string), maxRetentionAttr)
+			}
+			if retentionDays > typeMaxRetentionDays {
+				typeMaxRetentionDays, typeMaxRetentionAttr = retentionDays, itemAttr
+			}
+		}
+		maxRetentionDays, maxRetentionAttr = typeMaxRetentionDays, typeMaxRetentionAttr
+	}
+
+	return nil
+}
+
 func resourceMongoDBAtlasCloudBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*MongoDBClient).Atlas
@@ -319,8 +379,12 @@ func resourceMongoDBAtlasCloudBackupScheduleRead(ctx context.Context, d *schema.
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
-	backupPolicy, _, err := conn.CloudProviderSnapshotBackupPolicies.Get(context.Background(), projectID, clusterName)
+	backupPolicy, resp, err := conn.CloudProviderSnapshotBackupPolicies.Get(context.Background(), projectID, clusterName)
 	if err != nil {
+		// the schedule is gone along with its cluster
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_cloud_backup_schedule")
+		}
 		return diag.Errorf(errorSnapshotBackupScheduleRead, clusterName, err)
 	}
 
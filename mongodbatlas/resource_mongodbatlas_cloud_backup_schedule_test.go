@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -391,6 +392,41 @@ func TestAccBackupRSCloudBackupSchedule_azure(t *testing.T) {
 	})
 }
 
+func TestAccBackupRSCloudBackupSchedule_invalidPolicyItems(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc")
+		clusterName = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasCloudBackupScheduleBasicConfig(orgID, projectName, clusterName, &matlas.PolicyItem{
+					FrequencyInterval: 3,
+					RetentionUnit:     "days",
+					RetentionValue:    1,
+				}),
+				ExpectError: regexp.MustCompile(`expected policy_item_hourly.0.frequency_interval to be one of`),
+			},
+			{
+				Config: testAccMongoDBAtlasCloudBackupScheduleBasicConfig(orgID, projectName, clusterName, &matlas.PolicyItem{
+					FrequencyInterval: 1,
+					RetentionUnit:     "hours",
+					RetentionValue:    1,
+				}),
+				ExpectError: regexp.MustCompile(`expected policy_item_hourly.0.retention_unit to be one of`),
+			},
+			{
+				Config:      testAccMongoDBAtlasCloudBackupScheduleInconsistentRetentionConfig(orgID, projectName, clusterName),
+				ExpectError: regexp.MustCompile(`policy_item_daily.0.retention_value: the retention of a daily policy item`),
+			},
+		},
+	})
+}
+
 func testAccCheckMongoDBAtlasCloudBackupScheduleExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
@@ -723,6 +759,41 @@ data "mongodbatlas_cloud_backup_schedule" "schedule_test" {
 	`, orgID, projectName, clusterName, policy.FrequencyInterval, policy.RetentionUnit, policy.RetentionValue)
 }
 
+func testAccMongoDBAtlasCloudBackupScheduleInconsistentRetentionConfig(orgID, projectName, clusterName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "backup_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_cluster" "my_cluster" {
+  project_id   = mongodbatlas_project.backup_project.id
+  name         = %[3]q
+
+  // Provider Settings "block"
+  provider_name               = "AWS"
+  provider_region_name        = "EU_CENTRAL_1"
+  provider_instance_size_name = "M10"
+  cloud_backup                = true //enable cloud provider snapshots
+}
+
+resource "mongodbatlas_cloud_backup_schedule" "schedule_test" {
+  project_id   = mongodbatlas_cluster.my_cluster.project_id
+  cluster_name = mongodbatlas_cluster.my_cluster.name
+
+  policy_item_hourly {
+    frequency_interval = 1
+    retention_unit     = "weeks"
+    retention_value    = 1
+  }
+  policy_item_daily {
+    frequency_interval = 1
+    retention_unit     = "days"
+    retention_value    = 3
+  }
+}
+	`, orgID, projectName, clusterName)
+}
+
 func testAccMongoDBAtlasCloudBackupScheduleAdvancedPoliciesConfig(orgID, projectName, clusterName string, p *matlas.CloudProviderSnapshotBackupPolicy) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_project" "backup_project" {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -22,6 +23,7 @@ func resourceMongoDBAtlasCloudBackupSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasCloudBackupSnapshotCreate,
 		ReadContext:   resourceMongoDBAtlasCloudBackupSnapshotRead,
+		UpdateContext: resourceMongoDBAtlasCloudBackupSnapshotUpdate,
 		DeleteContext: resourceMongoDBAtlasCloudBackupSnapshotDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasCloudBackupSnapshotImportState,
@@ -45,7 +47,6 @@ func resourceMongoDBAtlasCloudBackupSnapshot() *schema.Resource {
 			"retention_in_days": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"created_at": {
@@ -137,8 +138,7 @@ func resourceMongoDBAtlasCloudBackupSnapshotRead(ctx context.Context, d *schema.
 	snapshot, resp, err := conn.CloudProviderSnapshots.GetOneCloudProviderSnapshot(context.Background(), requestParameters)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_cloud_backup_snapshot")
 		}
 
 		return diag.FromErr(fmt.Errorf("error getting snapshot Information: %s", err))
@@ -259,6 +259,26 @@ func resourceMongoDBAtlasCloudBackupSnapshotCreate(ctx context.Context, d *schem
 	return resourceMongoDBAtlasCloudBackupSnapshotRead(ctx, d, meta)
 }
 
+func resourceMongoDBAtlasCloudBackupSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	connV2 := meta.(*MongoDBClient).AtlasV2
+	ids := decodeStateID(d.Id())
+
+	if d.HasChange("retention_in_days") {
+		retention := &admin.BackupSnapshotRetention{
+			RetentionUnit:  "DAYS",
+			RetentionValue: d.Get("retention_in_days").(int),
+		}
+
+		_, _, err := connV2.CloudBackupsApi.UpdateSnapshotRetention(ctx, ids["project_id"], ids["cluster_name"], ids["snapshot_id"], retention).Execute()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating retention of snapshot (%s): %s", ids["snapshot_id"], err))
+		}
+	}
+
+	return resourceMongoDBAtlasCloudBackupSnapshotRead(ctx, d, meta)
+}
+
 func resourceMongoDBAtlasCloudBackupSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -103,8 +103,7 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportBucketRead(ctx context.Context
 		reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
 
 		if reset {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_cloud_backup_snapshot_export_bucket")
 		}
 
 		return diag.Errorf("error getting snapshot export backup information: %s", err)
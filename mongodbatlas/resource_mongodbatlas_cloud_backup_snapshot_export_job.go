@@ -4,25 +4,49 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+const (
+	exportJobStateQueued     = "Queued"
+	exportJobStateInProgress = "InProgress"
+	exportJobStateSuccessful = "Successful"
+	exportJobStateFailed     = "Failed"
+)
+
 func resourceMongoDBAtlasCloudBackupSnapshotExportJob() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasCloudBackupSnapshotExportJobCreate,
 		ReadContext:   resourceMongoDBAtlasCloudBackupSnapshotExportJobRead,
-		DeleteContext: schema.NoopContext,
+		UpdateContext: resourceMongoDBAtlasCloudBackupSnapshotExportJobUpdate,
+		DeleteContext: resourceMongoDBAtlasCloudBackupSnapshotExportJobDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasCloudBackupSnapshotExportJobImportState,
 		},
-		Schema: returnCloudBackupSnapshotExportJobSchema(),
+		Schema: resourceCloudBackupSnapshotExportJobSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+		},
 	}
 }
 
+// resourceCloudBackupSnapshotExportJobSchema adds the arguments that only control how the resource is applied.
+func resourceCloudBackupSnapshotExportJobSchema() map[string]*schema.Schema {
+	s := returnCloudBackupSnapshotExportJobSchema()
+	s["wait_for_completion"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	return s
+}
+
 func returnCloudBackupSnapshotExportJobSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"id": {
@@ -133,8 +157,7 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx context.Context, d
 		reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
 
 		if reset {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_cloud_backup_snapshot_export_job")
 		}
 
 		return diag.Errorf("error getting snapshot export job information: %s", err)
@@ -250,9 +273,58 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportJobCreate(ctx context.Context,
 		"export_job_id": jobResponse.ID,
 	}))
 
+	if !d.Get("wait_for_completion").(bool) {
+		return resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx, d, meta)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{exportJobStateQueued, exportJobStateInProgress},
+		Target:     []string{exportJobStateSuccessful},
+		Refresh:    resourceCloudBackupSnapshotExportJobRefreshFunc(ctx, conn, projectID, clusterName, jobResponse.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	// Wait, catching any errors. The resource is kept in the state, tainted, when the export job fails.
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for snapshot export job (%s) to finish: %s", jobResponse.ID, err)
+	}
+
 	return resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx, d, meta)
 }
 
+func resourceCloudBackupSnapshotExportJobRefreshFunc(ctx context.Context, conn *matlas.Client, projectID, clusterName, exportID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		exportJob, _, err := conn.CloudProviderSnapshotExportJobs.Get(ctx, projectID, clusterName, exportID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if exportJob.State == exportJobStateFailed {
+			return nil, exportJob.State, fmt.Errorf("export job failed: %s", exportJob.ErrMsg)
+		}
+
+		return exportJob, exportJob.State, nil
+	}
+}
+
+// resourceMongoDBAtlasCloudBackupSnapshotExportJobUpdate only stores wait_for_completion, which has no effect once the
+// export job was created.
+func resourceMongoDBAtlasCloudBackupSnapshotExportJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceMongoDBAtlasCloudBackupSnapshotExportJobRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasCloudBackupSnapshotExportJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Atlas doesn't allow cancelling export jobs, destroying the resource only removes the job from the state
+	if state := d.Get("state").(string); state == exportJobStateQueued || state == exportJobStateInProgress {
+		log.Printf("[WARN] snapshot export job (%s) is %s, it can't be cancelled and will keep running", d.Get("export_job_id").(string), state)
+	}
+
+	d.SetId("")
+	return nil
+}
+
 func expandExportJobCustomData(d *schema.ResourceData) []*matlas.CloudProviderSnapshotExportJobCustomData {
 	customData := d.Get("custom_data").(*schema.Set)
 	res := make([]*matlas.CloudProviderSnapshotExportJobCustomData, customData.Len())
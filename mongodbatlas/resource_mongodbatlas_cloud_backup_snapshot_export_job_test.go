@@ -32,6 +32,7 @@ func TestAccBackupRSBackupSnapshotExportJob_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
 					resource.TestCheckResourceAttr(resourceName, "bucket_name", "example-bucket"),
 					resource.TestCheckResourceAttr(resourceName, "cloud_provider", "AWS"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Successful"),
 				),
 			},
 		},
@@ -60,6 +61,8 @@ func TestAccBackupRSBackupSnapshotExportJob_importBasic(t *testing.T) {
 				ImportStateIdFunc: testAccCheckMongoDBAtlasBackupSnapshotExportJobImportStateIDFunc(resourceName),
 				ImportState:       true,
 				ImportStateVerify: true,
+				// wait_for_completion only applies to the creation, Atlas doesn't return it
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
 			},
 		},
 	})
@@ -153,6 +156,7 @@ resource "mongodbatlas_cloud_backup_snapshot_export_job" "test" {
   cluster_name = mongodbatlas_cluster.my_cluster.name
   snapshot_id = mongodbatlas_cloud_backup_snapshot.test.snapshot_id
   export_bucket_id = mongodbatlas_cloud_backup_snapshot_export_bucket.test.export_bucket_id
+  wait_for_completion = true
 
   custom_data {
     key   = "exported by"
@@ -19,6 +19,7 @@ func TestAccBackupRSCloudBackupSnapshotRestoreJob_basic(t *testing.T) {
 		resourceName                      = "mongodbatlas_cloud_backup_snapshot_restore_job.test"
 		snapshotsDataSourceName           = "data.mongodbatlas_cloud_backup_snapshot_restore_jobs.test"
 		snapshotsDataSourcePaginationName = "data.mongodbatlas_cloud_backup_snapshot_restore_jobs.pagination"
+		restoreJobsDataSourceName         = "data.mongodbatlas_restore_jobs.test"
 		dataSourceName                    = "data.mongodbatlas_cloud_backup_snapshot_restore_job.test"
 		orgID                             = os.Getenv("MONGODB_ATLAS_ORG_ID")
 		projectName                       = acctest.RandomWithPrefix("test-snapshot-acc")
@@ -44,6 +45,9 @@ func TestAccBackupRSCloudBackupSnapshotRestoreJob_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "snapshot_id"),
 					resource.TestCheckResourceAttrSet(snapshotsDataSourceName, "results.#"),
 					resource.TestCheckResourceAttrSet(snapshotsDataSourcePaginationName, "results.#"),
+					resource.TestCheckResourceAttr(restoreJobsDataSourceName, "total_count", "1"),
+					resource.TestCheckResourceAttr(restoreJobsDataSourceName, "results.0.cluster_name", clusterName),
+					resource.TestCheckResourceAttrSet(restoreJobsDataSourceName, "results.0.status"),
 				),
 			},
 			{
@@ -256,6 +260,12 @@ data "mongodbatlas_cloud_backup_snapshot_restore_jobs" "pagination" {
 	items_per_page = 5
 }
 
+data "mongodbatlas_restore_jobs" "test" {
+	project_id    = mongodbatlas_cloud_backup_snapshot_restore_job.test.project_id
+	cluster_names = [mongodbatlas_cloud_backup_snapshot_restore_job.test.cluster_name]
+	delivery_type = "automated"
+}
+
 	`, orgID, projectName, clusterName, description, retentionInDays, targetProjectName, targetClusterName)
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/go-test/deep"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -20,6 +21,7 @@ func TestAccBackupRSCloudBackupSnapshot_basic(t *testing.T) {
 		resourceName                      = "mongodbatlas_cloud_backup_snapshot.test"
 		snapshotsDataSourceName           = "data.mongodbatlas_cloud_backup_snapshots.test"
 		snapshotsDataSourcePaginationName = "data.mongodbatlas_cloud_backup_snapshots.pagination"
+		snapshotsDataSourceFilteredName   = "data.mongodbatlas_cloud_backup_snapshots.filtered"
 		dataSourceName                    = "data.mongodbatlas_cloud_backup_snapshot.test"
 		orgID                             = os.Getenv("MONGODB_ATLAS_ORG_ID")
 		projectName                       = acctest.RandomWithPrefix("test-acc")
@@ -46,6 +48,21 @@ func TestAccBackupRSCloudBackupSnapshot_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "description"),
 					resource.TestCheckResourceAttrSet(snapshotsDataSourceName, "results.#"),
 					resource.TestCheckResourceAttrSet(snapshotsDataSourcePaginationName, "results.#"),
+					resource.TestCheckResourceAttrSet(snapshotsDataSourceFilteredName, "results.#"),
+					resource.TestCheckResourceAttr(snapshotsDataSourceFilteredName, "results.0.status", "completed"),
+					resource.TestCheckResourceAttrSet(snapshotsDataSourceFilteredName, "results.0.retention_in_days"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasCloudBackupSnapshotConfig(orgID, projectName, clusterName, description, "7"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasCloudBackupSnapshotExists(resourceName, &cloudBackupSnapshot),
+					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "7"),
 				),
 			},
 			{
@@ -182,6 +199,14 @@ data "mongodbatlas_cloud_backup_snapshots" "pagination" {
 	items_per_page = 5
 }
 
+data "mongodbatlas_cloud_backup_snapshots" "filtered" {
+	project_id        = mongodbatlas_cluster.my_cluster.project_id
+	cluster_name      = mongodbatlas_cluster.my_cluster.name
+	status            = "completed"
+	created_after     = "2020-01-01T00:00:00Z"
+
+	depends_on = [mongodbatlas_cloud_backup_snapshot.test]
+}
 
 
 	`, orgID, projectName, clusterName, description, retentionInDays)
@@ -122,8 +122,7 @@ func resourceMongoDBAtlasCloudProviderAccessRead(ctx context.Context, d *schema.
 	role, resp, err := conn.CloudProviderAccess.GetRole(context.Background(), projectID, roleID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_cloud_provider_access")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorGetRead, err))
@@ -99,8 +99,7 @@ func resourceMongoDBAtlasCloudProviderAccessAuthorizationRead(ctx context.Contex
 	if err != nil {
 		reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
 		if reset {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_cloud_provider_access_authorization")
 		}
 
 		return diag.FromErr(err)
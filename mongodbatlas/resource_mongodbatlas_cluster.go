@@ -30,6 +30,9 @@ const (
 	errorClusterSetting     = "error setting `%s` for MongoDB Cluster (%s): %s"
 	errorAdvancedConfUpdate = "error updating Advanced Configuration Option form MongoDB Cluster (%s): %s"
 	errorAdvancedConfRead   = "error reading Advanced Configuration Option form MongoDB Cluster (%s): %s"
+	clusterProcessArgsPath  = "api/atlas/v1.0/groups/%s/clusters/%s/processArgs"
+
+	errorTerminationProtectionEnabled = "%s (%s) has termination protection enabled, set `termination_protection_enabled` to false and apply before destroying it"
 )
 
 var defaultLabel = matlas.Label{Key: "Infrastructure Tool", Value: "MongoDB Atlas Terraform Provider"}
@@ -326,6 +329,7 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				},
 			},
 			"tags":                   &tagsSchema,
+			"tags_all":               &tagsAllSchema,
 			"snapshot_backup_policy": computedCloudProviderSnapshotBackupPolicySchema(),
 			"termination_protection_enabled": {
 				Type:     schema.TypeBool,
@@ -485,8 +489,7 @@ func resourceMongoDBAtlasClusterCreate(ctx context.Context, d *schema.ResourceDa
 
 	clusterRequest.Labels = append(expandLabelSliceFromSetSchema(d), defaultLabel)
 
-	if _, ok := d.GetOk("tags"); ok {
-		tagsSlice := expandTagSliceFromSetSchema(d)
+	if tagsSlice := mergeTags(meta.(*MongoDBClient).Config.DefaultTags, d.Get("tags").(*schema.Set)); len(tagsSlice) > 0 {
 		clusterRequest.Tags = &tagsSlice
 	}
 
@@ -586,8 +589,7 @@ func resourceMongoDBAtlasClusterRead(ctx context.Context, d *schema.ResourceData
 	cluster, resp, err := conn.Clusters.Get(ctx, projectID, clusterName)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_cluster")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorClusterRead, clusterName, err))
@@ -708,10 +710,19 @@ func resourceMongoDBAtlasClusterRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(fmt.Errorf(errorClusterSetting, "labels", clusterName, err))
 	}
 
-	if err := d.Set("tags", flattenTags(cluster.Tags)); err != nil {
+	var atlasTags []*matlas.Tag
+	if cluster.Tags != nil {
+		atlasTags = *cluster.Tags
+	}
+	tags := removeDefaultTags(atlasTags, meta.(*MongoDBClient).Config.DefaultTags, d.Get("tags").(*schema.Set))
+	if err := d.Set("tags", flattenTags(&tags)); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterSetting, "tags", clusterName, err))
 	}
 
+	if err := d.Set("tags_all", flattenTags(cluster.Tags)); err != nil {
+		return diag.FromErr(fmt.Errorf(errorClusterSetting, "tags_all", clusterName, err))
+	}
+
 	if err := d.Set("version_release_system", cluster.VersionReleaseSystem); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterSetting, "version_release_system", clusterName, err))
 	}
@@ -868,8 +879,8 @@ func resourceMongoDBAtlasClusterUpdate(ctx context.Context, d *schema.ResourceDa
 		cluster.Labels = append(expandLabelSliceFromSetSchema(d), defaultLabel)
 	}
 
-	if d.HasChange("tags") {
-		tagsSlice := expandTagSliceFromSetSchema(d)
+	if d.HasChange("tags") || d.HasChange("tags_all") {
+		tagsSlice := mergeTags(meta.(*MongoDBClient).Config.DefaultTags, d.Get("tags").(*schema.Set))
 		cluster.Tags = &tagsSlice
 	}
 
@@ -894,12 +905,15 @@ func resourceMongoDBAtlasClusterUpdate(ctx context.Context, d *schema.ResourceDa
 		if aclist, ok1 := ac.([]interface{}); ok1 && len(aclist) > 0 {
 			advancedConfReq := expandProcessArgs(d, aclist[0].(map[string]interface{}))
 			if !reflect.DeepEqual(advancedConfReq, matlas.ProcessArgs{}) {
-				argResp, _, err := conn.Clusters.UpdateProcessArgs(ctx, projectID, clusterName, advancedConfReq)
+				_, _, err := conn.Clusters.UpdateProcessArgs(ctx, projectID, clusterName, advancedConfReq)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf(errorAdvancedConfUpdate, clusterName+argResp.DefaultReadConcern, err))
+					return diag.FromErr(fmt.Errorf(errorAdvancedConfUpdate, clusterName, err))
 				}
 			}
 		}
+		if err := resetOplogMinRetentionHours(ctx, d, conn, projectID, clusterName); err != nil {
+			return diag.FromErr(fmt.Errorf(errorAdvancedConfUpdate, clusterName, err))
+		}
 	}
 
 	if isUpgradeRequired(d) {
@@ -970,6 +984,10 @@ func resourceMongoDBAtlasClusterDelete(ctx context.Context, d *schema.ResourceDa
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
+	if d.Get("termination_protection_enabled").(bool) {
+		return diag.Errorf(errorTerminationProtectionEnabled, "MongoDB Cluster", clusterName)
+	}
+
 	var options *matlas.DeleteAdvanceClusterOptions
 	if v, ok := d.GetOkExists("retain_backups_enabled"); ok {
 		options = &matlas.DeleteAdvanceClusterOptions{
@@ -1005,7 +1023,7 @@ func resourceMongoDBAtlasClusterDelete(ctx context.Context, d *schema.ResourceDa
 func resourceMongoDBAtlasClusterImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*MongoDBClient).Atlas
 
-	projectID, name, err := splitSClusterImportID(d.Id())
+	projectID, name, err := resolveClusterImportID(ctx, conn, d.Id(), splitSClusterImportID)
 	if err != nil {
 		return nil, err
 	}
@@ -1042,7 +1060,7 @@ func splitSClusterImportID(id string) (projectID, clusterName *string, err error
 	parts := re.FindStringSubmatch(id)
 
 	if len(parts) != 3 {
-		err = errors.New("import format error: to import a cluster, use the format {project_id}-{name}")
+		err = errors.New("import format error: to import a cluster, use the format {project_id}-{name} or {org_name}/{project_name}/{name}")
 		return
 	}
 
@@ -1368,7 +1386,7 @@ func expandProcessArgs(d *schema.ResourceData, p map[string]interface{}) *matlas
 	}
 
 	if _, ok := d.GetOkExists("advanced_configuration.0.oplog_min_retention_hours"); ok {
-		if minRetentionHours := cast.ToFloat64(p["oplog_min_retention_hours"]); minRetentionHours >= 0 {
+		if minRetentionHours := cast.ToFloat64(p["oplog_min_retention_hours"]); minRetentionHours > 0 {
 			res.OplogMinRetentionHours = pointy.Float64(cast.ToFloat64(p["oplog_min_retention_hours"]))
 		} else {
 			log.Printf(errorClusterSetting, `oplog_min_retention_hours`, "", cast.ToString(minRetentionHours))
@@ -1386,6 +1404,25 @@ func expandProcessArgs(d *schema.ResourceData, p map[string]interface{}) *matlas
 	return res
 }
 
+// resetOplogMinRetentionHours goes back to the minimum oplog window calculated by Atlas when
+// `oplog_min_retention_hours` is removed or set to 0, which requires sending an explicit null.
+func resetOplogMinRetentionHours(ctx context.Context, d *schema.ResourceData, conn *matlas.Client, projectID, clusterName string) error {
+	oldHours, newHours := d.GetChange("advanced_configuration.0.oplog_min_retention_hours")
+	if cast.ToInt(oldHours) == 0 || cast.ToInt(newHours) != 0 {
+		return nil
+	}
+
+	req, err := conn.NewRequest(ctx, http.MethodPatch, fmt.Sprintf(clusterProcessArgsPath, projectID, clusterName), map[string]interface{}{
+		"oplogMinRetentionHours": nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do(ctx, req, nil)
+	return err
+}
+
 func flattenProcessArgs(p *matlas.ProcessArgs) []interface{} {
 	return []interface{}{
 		map[string]interface{}{
@@ -1444,8 +1481,11 @@ func resourceClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m
 	} else if willProviderChange {
 		err = d.ForceNew("provider_name")
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	return customizeDiffTags(d, meta)
 }
 
 func formatMongoDBMajorVersion(val interface{}) string {
@@ -1655,18 +1695,21 @@ func clusterAdvancedConfigurationSchema() *schema.Schema {
 					Computed: true,
 				},
 				"oplog_min_retention_hours": {
-					Type:     schema.TypeInt,
-					Optional: true,
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
 				},
 				"sample_size_bi_connector": {
-					Type:     schema.TypeInt,
-					Optional: true,
-					Computed: true,
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
 				},
 				"sample_refresh_interval_bi_connector": {
-					Type:     schema.TypeInt,
-					Optional: true,
-					Computed: true,
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
 				},
 				"transaction_lifetime_limit_seconds": {
 					Type:     schema.TypeInt,
@@ -140,8 +140,7 @@ func resourceMongoDBAClusterOutageSimulationRead(ctx context.Context, d *schema.
 
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_cluster_outage_simulation")
 		}
 		return diag.FromErr(fmt.Errorf(errorClusterOutageSimulationRead, projectID, clusterName, err))
 	}
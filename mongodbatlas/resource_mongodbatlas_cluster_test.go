@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -179,6 +180,46 @@ func TestAccClusterRSCluster_basic_Partial_AdvancedConf(t *testing.T) {
 	})
 }
 
+func TestAccClusterRSCluster_withOplogMinRetentionAndBIConnectorSampling(t *testing.T) {
+	var (
+		cluster      matlas.Cluster
+		resourceName = "mongodbatlas_cluster.oplog_retention"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasClusterConfigOplogMinRetention(orgID, projectName, name, "oplog_min_retention_hours = 48"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "advanced_configuration.0.oplog_min_retention_hours", "48"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_configuration.0.sample_size_bi_connector", "200"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_configuration.0.sample_refresh_interval_bi_connector", "600"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasClusterConfigOplogMinRetention(orgID, projectName, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "advanced_configuration.0.oplog_min_retention_hours", "0"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_configuration.0.sample_size_bi_connector", "200"),
+				),
+			},
+			{
+				Config:      testAccMongoDBAtlasClusterConfigOplogMinRetention(orgID, projectName, name, "oplog_min_retention_hours = -1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected advanced_configuration.0.oplog_min_retention_hours to be at least"),
+			},
+		},
+	})
+}
+
 func TestAccClusterRSCluster_basic_DefaultWriteRead_AdvancedConf(t *testing.T) {
 	var (
 		cluster      matlas.Cluster
@@ -1097,6 +1138,13 @@ func TestAccClusterRSCluster_importBasic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"cloud_backup", "retain_backups_enabled"},
 			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccImportByNamesStateIDFunc(resourceName, "name"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cloud_backup", "retain_backups_enabled"},
+			},
 		},
 	})
 }
@@ -1590,6 +1638,47 @@ func testAccMongoDBAtlasClusterConfigAdvancedConf(orgID, projectName, name, auto
 		*p.OplogSizeMB, *p.SampleSizeBIConnector, *p.SampleRefreshIntervalBIConnector, *p.TransactionLifetimeLimitSeconds)
 }
 
+func testAccMongoDBAtlasClusterConfigOplogMinRetention(orgID, projectName, name, oplogMinRetention string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "cluster_project" {
+	name   = %[2]q
+	org_id = %[1]q
+}
+resource "mongodbatlas_cluster" "oplog_retention" {
+  project_id   = mongodbatlas_project.cluster_project.id
+  name         = %[3]q
+  disk_size_gb = 10
+  cluster_type = "REPLICASET"
+  replication_specs {
+    num_shards = 1
+    regions_config {
+      region_name     = "EU_CENTRAL_1"
+      electable_nodes = 3
+      priority        = 7
+      read_only_nodes = 0
+    }
+  }
+
+  backup_enabled               = false
+  auto_scaling_disk_gb_enabled = true
+
+  // Provider Settings "block"
+  provider_name               = "AWS"
+  provider_instance_size_name = "M10"
+
+  bi_connector_config {
+    enabled = true
+  }
+
+  advanced_configuration {
+    %[4]s
+    sample_size_bi_connector             = 200
+    sample_refresh_interval_bi_connector = 600
+  }
+}
+	`, orgID, projectName, name, oplogMinRetention)
+}
+
 func testAccMongoDBAtlasClusterConfigAdvancedConfDefaultWriteRead(orgID, projectName, name, autoscalingEnabled string, p *matlas.ProcessArgs) string {
 	return fmt.Sprintf(`
 resource "mongodbatlas_project" "cluster_project" {
@@ -164,8 +164,7 @@ func resourceMongoDBAtlasCustomDBRoleRead(ctx context.Context, d *schema.Resourc
 	customDBRole, resp, err := conn.CustomDBRoles.Get(context.Background(), projectID, roleName)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_custom_db_role")
 		}
 
 		return diag.FromErr(fmt.Errorf("error getting custom db role information: %s", err))
@@ -287,8 +287,7 @@ func resourceMongoDBAtlasDataLakePipelineRead(ctx context.Context, d *schema.Res
 
 	dataLakePipeline, resp, err := conn.DataLakePipeline.Get(ctx, projectID, name)
 	if resp != nil && resp.StatusCode == http.StatusNotFound {
-		d.SetId("")
-		return nil
+		return removeExternallyDeletedResource(d, meta, "mongodbatlas_data_lake_pipeline")
 	}
 
 	if err != nil {
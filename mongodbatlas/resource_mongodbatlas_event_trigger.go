@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/go-test/deep"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,8 +25,15 @@ const (
 	errorEventTriggersRead    = "error reading MongoDB EventTriggers (%s)%s: %s"
 	errorEventTriggersDelete  = "error deleting MongoDB EventTriggers (%s)%s: %s"
 	errorEventTriggersSetting = "error setting `%s` for EventTriggers(%s)%s: %s"
+	errorEventTriggersResume  = "error resuming MongoDB EventTriggers (%s)%s: %s"
+	eventTriggerPath          = "groups/%s/apps/%s/triggers/%s"
 )
 
+// eventTriggerStatus holds the trigger fields that the realm client doesn't model yet.
+type eventTriggerStatus struct {
+	Error string `json:"error,omitempty"`
+}
+
 func resourceMongoDBAtlasEventTriggers() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasEventTriggersCreate,
@@ -35,6 +43,7 @@ func resourceMongoDBAtlasEventTriggers() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasEventTriggerImportState,
 		},
+		CustomizeDiff: resourceEventTriggerCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -104,9 +113,10 @@ func resourceMongoDBAtlasEventTriggers() *schema.Resource {
 				Computed: true,
 			},
 			"config_match": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEventTriggerExpression,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					var j, j2 interface{}
 					if err := json.Unmarshal([]byte(old), &j); err != nil {
@@ -126,9 +136,10 @@ func resourceMongoDBAtlasEventTriggers() *schema.Resource {
 				},
 			},
 			"config_project": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEventTriggerExpression,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					var j, j2 interface{}
 					if err := json.Unmarshal([]byte(old), &j); err != nil {
@@ -206,10 +217,49 @@ func resourceMongoDBAtlasEventTriggers() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"auto_resume": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tolerate_resume_errors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"suspended": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"suspended_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// validateEventTriggerExpression checks that config_match and config_project are JSON objects, which is
+// otherwise only reported by App Services once the trigger is applied.
+func validateEventTriggerExpression(v interface{}, p cty.Path) diag.Diagnostics {
+	var expression map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &expression); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid trigger expression",
+			Detail:        fmt.Sprintf("expected a JSON object: %s", err),
+			AttributePath: p,
+		}}
+	}
+	return nil
+}
+
+func resourceEventTriggerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A suspended trigger is resumed on apply when auto_resume is set, so the plan shows the pending resume.
+	if d.Id() != "" && d.Get("auto_resume").(bool) && d.Get("suspended").(bool) {
+		return d.SetNew("suspended", false)
+	}
+	return nil
+}
+
 func resourceMongoDBAtlasEventTriggersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
 	if err != nil {
@@ -326,8 +376,7 @@ func resourceMongoDBAtlasEventTriggersRead(ctx context.Context, d *schema.Resour
 	resp, recodes, err := conn.EventTriggers.Get(ctx, projectID, appID, triggerID)
 	if err != nil {
 		if recodes != nil && recodes.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_event_trigger")
 		}
 		return diag.FromErr(fmt.Errorf(errorEventTriggersRead, projectID, appID, err))
 	}
@@ -399,6 +448,17 @@ func resourceMongoDBAtlasEventTriggersRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "event_processors", projectID, appID, err))
 	}
 
+	status, err := getEventTriggerStatus(ctx, conn, projectID, appID, triggerID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorEventTriggersRead, projectID, appID, err))
+	}
+	if err = d.Set("suspended", status.Error != ""); err != nil {
+		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "suspended", projectID, appID, err))
+	}
+	if err = d.Set("suspended_reason", status.Error); err != nil {
+		return diag.FromErr(fmt.Errorf(errorEventTriggersSetting, "suspended_reason", projectID, appID, err))
+	}
+
 	return nil
 }
 
@@ -453,7 +513,13 @@ func resourceMongoDBAtlasEventTriggersUpdate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf(errorEventTriggersUpdate, projectID, appID, err))
 	}
 
-	return nil
+	if wasSuspended, _ := d.GetChange("suspended"); d.Get("auto_resume").(bool) && wasSuspended.(bool) {
+		if err = resumeEventTrigger(ctx, conn, projectID, appID, triggerID, d.Get("tolerate_resume_errors").(bool)); err != nil {
+			return diag.FromErr(fmt.Errorf(errorEventTriggersResume, projectID, appID, err))
+		}
+	}
+
+	return resourceMongoDBAtlasEventTriggersRead(ctx, d, meta)
 }
 
 func resourceMongoDBAtlasEventTriggersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -554,6 +620,35 @@ func resourceMongoDBAtlasEventTriggerImportState(ctx context.Context, d *schema.
 	return []*schema.ResourceData{d}, nil
 }
 
+func getEventTriggerStatus(ctx context.Context, conn *realm.Client, projectID, appID, triggerID string) (*eventTriggerStatus, error) {
+	req, err := conn.NewRequest(ctx, http.MethodGet, fmt.Sprintf(eventTriggerPath, projectID, appID, triggerID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(eventTriggerStatus)
+	if _, err = conn.Do(ctx, req, root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// resumeEventTrigger resumes a suspended trigger. With tolerateResumeErrors the trigger restarts without its resume
+// token, skipping the change events that happened while it was suspended instead of failing if they are gone.
+func resumeEventTrigger(ctx context.Context, conn *realm.Client, projectID, appID, triggerID string, tolerateResumeErrors bool) error {
+	body := map[string]interface{}{
+		"disable_token": tolerateResumeErrors,
+	}
+	req, err := conn.NewRequest(ctx, http.MethodPut, fmt.Sprintf(eventTriggerPath+"/resume", projectID, appID, triggerID), body)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do(ctx, req, nil)
+	return err
+}
+
 func matchToString(value interface{}) string {
 	b, err := json.Marshal(value)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -58,6 +59,7 @@ func TestAccConfigRSEventTriggerDatabase_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMongoDBAtlasEventTriggerExists(resourceName, &eventResp),
 					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(resourceName, "suspended", "false"),
 				),
 			},
 			{
@@ -77,6 +79,37 @@ func TestAccConfigRSEventTriggerDatabase_basic(t *testing.T) {
 	})
 }
 
+func TestAccConfigRSEventTriggerDatabase_invalidMatch(t *testing.T) {
+	SkipTestForCI(t)
+	var (
+		projectID = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		appID     = os.Getenv("MONGODB_REALM_APP_ID")
+	)
+	event := realm.EventTriggerRequest{
+		Name:       acctest.RandomWithPrefix("test-acc"),
+		Type:       "DATABASE",
+		FunctionID: os.Getenv("MONGODB_REALM_FUNCTION_ID"),
+		Disabled:   pointy.Bool(false),
+		Config: &realm.EventTriggerConfig{
+			Database:   "sample_airbnb",
+			Collection: "listingsAndReviews",
+			ServiceID:  os.Getenv("MONGODB_REALM_SERVICE_ID"),
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasEventTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasEventTriggerDatabaseConfigMatch(projectID, appID, `[{\"status\":\"blocked\"}]`, &event),
+				ExpectError: regexp.MustCompile("expected a JSON object"),
+			},
+		},
+	})
+}
+
 func TestAccConfigRSEventTriggerDatabase_eventProccesor(t *testing.T) {
 	SkipTestForCI(t)
 	var (
@@ -527,6 +560,27 @@ func testAccMongoDBAtlasEventTriggerDatabaseConfigDatabase(projectID, appID, ope
 		eventTrigger.Config.ServiceID, fullDoc, fullDocBefore)
 }
 
+func testAccMongoDBAtlasEventTriggerDatabaseConfigMatch(projectID, appID, match string, eventTrigger *realm.EventTriggerRequest) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_event_trigger" "test" {
+			project_id = %[1]q
+			app_id = %[2]q
+			name = %[3]q
+			type = %[4]q
+			function_id = %[5]q
+			disabled = %[6]t
+			config_operation_types = ["INSERT"]
+			config_database = %[7]q
+			config_collection = %[8]q
+			config_service_id = %[9]q
+			config_match = "%[10]s"
+			auto_resume = true
+			tolerate_resume_errors = true
+		}
+	`, projectID, appID, eventTrigger.Name, eventTrigger.Type, eventTrigger.FunctionID, *eventTrigger.Disabled,
+		eventTrigger.Config.Database, eventTrigger.Config.Collection, eventTrigger.Config.ServiceID, match)
+}
+
 func testAccMongoDBAtlasEventTriggerDatabaseConfigDatabaseEP(projectID, appID, operationTypes, awsAccID, awsRegion string, eventTrigger *realm.EventTriggerRequest) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_event_trigger" "test" {
@@ -2,6 +2,7 @@ package mongodbatlas
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 const (
@@ -19,8 +21,46 @@ const (
 	errorFederatedDatabaseInstanceDelete  = "error deleting MongoDB Atlas Federated Database Instace (%s): %s"
 	errorFederatedDatabaseInstanceUpdate  = "error updating MongoDB Atlas Federated Database Instace (%s): %s"
 	errorFederatedDatabaseInstanceSetting = "error setting `%s` for MongoDB Atlas Federated Database Instace (%s): %s"
+	dataFederationPath                    = "api/atlas/v2/groups/%s/dataFederation"
+	dataFederationStoreProviderAzure      = "azure"
+	dataFederationStoreProviderGCS        = "gcs"
 )
 
+// dataFederationTenant holds the Azure and GCP configuration of a federated database instance, which the
+// admin client doesn't support yet.
+type dataFederationTenant struct {
+	CloudProviderConfig *dataFederationCloudProviderConfig `json:"cloudProviderConfig,omitempty"`
+	Storage             *dataFederationStorage             `json:"storage,omitempty"`
+}
+
+type dataFederationCloudProviderConfig struct {
+	Azure *dataFederationAzureConfig `json:"azure,omitempty"`
+	GCP   *dataFederationGCPConfig   `json:"gcp,omitempty"`
+}
+
+type dataFederationAzureConfig struct {
+	RoleID             string `json:"roleId"`
+	AtlasAppID         string `json:"atlasAppId,omitempty"`
+	ServicePrincipalID string `json:"servicePrincipalId,omitempty"`
+	TenantID           string `json:"tenantId,omitempty"`
+}
+
+type dataFederationGCPConfig struct {
+	RoleID            string `json:"roleId"`
+	GCPServiceAccount string `json:"gcpServiceAccount,omitempty"`
+}
+
+type dataFederationStorage struct {
+	Stores []dataFederationStore `json:"stores,omitempty"`
+}
+
+type dataFederationStore struct {
+	Name                 string `json:"name,omitempty"`
+	ServiceURL           string `json:"serviceURL,omitempty"`
+	ContainerName        string `json:"containerName,omitempty"`
+	ReplacementDelimiter string `json:"replacementDelimiter,omitempty"`
+}
+
 func resourceMongoDBAtlasFederatedDatabaseInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBFederatedDatabaseInstanceCreate,
@@ -30,6 +70,7 @@ func resourceMongoDBAtlasFederatedDatabaseInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasFederatedDatabaseInstanceImportState,
 		},
+		CustomizeDiff: resourceFederatedDatabaseInstanceCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -57,9 +98,10 @@ func resourceMongoDBAtlasFederatedDatabaseInstance() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"aws": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Required: true,
+							Type:         schema.TypeList,
+							MaxItems:     1,
+							Optional:     true,
+							ExactlyOneOf: []string{"cloud_provider_config.0.aws", "cloud_provider_config.0.azure", "cloud_provider_config.0.gcp"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"role_id": {
@@ -85,6 +127,48 @@ func resourceMongoDBAtlasFederatedDatabaseInstance() *schema.Resource {
 								},
 							},
 						},
+						"azure": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"atlas_app_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service_principal_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tenant_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"gcp": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"gcp_service_account": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -261,6 +345,18 @@ func schemaFederatedDatabaseInstanceStores() *schema.Schema {
 					Type:     schema.TypeString,
 					Optional: true,
 				},
+				"service_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"container_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"replacement_delimiter": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
 				"include_tags": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -338,15 +434,23 @@ func schemaFederatedDatabaseInstanceStores() *schema.Schema {
 func resourceMongoDBFederatedDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	connV2 := meta.(*MongoDBClient).AtlasV2
 
+	conn := meta.(*MongoDBClient).Atlas
+
 	projectID := d.Get("project_id").(string)
 	name := d.Get("name").(string)
 
-	if _, _, err := connV2.DataFederationApi.CreateFederatedDatabase(ctx, projectID, &admin.DataLakeTenant{
+	dataLakeTenant := &admin.DataLakeTenant{
 		Name:                stringPtr(name),
 		CloudProviderConfig: newCloudProviderConfig(d),
 		DataProcessRegion:   newDataProcessRegion(d),
 		Storage:             newDataFederationStorage(d),
-	}).Execute(); err != nil {
+	}
+
+	if tenant := newDataFederationTenant(d); tenant != nil {
+		if err := sendDataFederationTenant(ctx, conn, http.MethodPost, fmt.Sprintf(dataFederationPath, projectID), dataLakeTenant, tenant); err != nil {
+			return diag.FromErr(fmt.Errorf(errorFederatedDatabaseInstanceCreate, err))
+		}
+	} else if _, _, err := connV2.DataFederationApi.CreateFederatedDatabase(ctx, projectID, dataLakeTenant).Execute(); err != nil {
 		return diag.FromErr(fmt.Errorf(errorFederatedDatabaseInstanceCreate, err))
 	}
 
@@ -367,15 +471,24 @@ func resourceMongoDBAFederatedDatabaseInstanceRead(ctx context.Context, d *schem
 	dataFederationInstance, resp, err := connV2.DataFederationApi.GetFederatedDatabase(ctx, projectID, name).Execute()
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_federated_database_instance")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorFederatedDatabaseInstanceRead, name, err))
 	}
 
 	if val, ok := dataFederationInstance.GetCloudProviderConfigOk(); ok {
-		if cloudProviderField := flattenCloudProviderConfig(d, val); cloudProviderField != nil {
+		cloudProviderField := flattenCloudProviderConfig(d, val)
+		if val.Aws.GetRoleId() == "" {
+			// the instance uses Azure or GCP, which are only part of the raw response
+			tenant, errTenant := getDataFederationTenant(ctx, meta.(*MongoDBClient).Atlas, projectID, name)
+			if errTenant != nil {
+				return diag.FromErr(fmt.Errorf(errorFederatedDatabaseInstanceRead, name, errTenant))
+			}
+			cloudProviderField = flattenDataFederationCloudProviderConfig(tenant.CloudProviderConfig)
+		}
+
+		if cloudProviderField != nil {
 			if err = d.Set("cloud_provider_config", cloudProviderField); err != nil {
 				return diag.FromErr(fmt.Errorf(errorFederatedDatabaseInstanceSetting, "cloud_provider_config", name, err))
 			}
@@ -413,6 +526,8 @@ func resourceMongoDBFederatedDatabaseInstanceUpdate(ctx context.Context, d *sche
 	projectID := ids["project_id"]
 	name := ids["name"]
 
+	conn := meta.(*MongoDBClient).Atlas
+
 	dataLakeTenant := &admin.DataLakeTenant{
 		Name:                stringPtr(name),
 		CloudProviderConfig: newCloudProviderConfig(d),
@@ -420,6 +535,14 @@ func resourceMongoDBFederatedDatabaseInstanceUpdate(ctx context.Context, d *sche
 		Storage:             newDataFederationStorage(d),
 	}
 
+	if tenant := newDataFederationTenant(d); tenant != nil {
+		path := fmt.Sprintf("%s/%s?skipRoleValidation=false", fmt.Sprintf(dataFederationPath, projectID), name)
+		if err := sendDataFederationTenant(ctx, conn, http.MethodPatch, path, dataLakeTenant, tenant); err != nil {
+			return diag.FromErr(fmt.Errorf(errorFederatedDatabaseInstanceUpdate, name, err))
+		}
+		return resourceMongoDBAFederatedDatabaseInstanceRead(ctx, d, meta)
+	}
+
 	if _, _, err := connV2.DataFederationApi.UpdateFederatedDatabaseWithParams(ctx, &admin.UpdateFederatedDatabaseApiParams{
 		GroupId:            projectID,
 		TenantName:         name,
@@ -483,8 +606,18 @@ func resourceMongoDBAtlasFederatedDatabaseInstanceImportState(ctx context.Contex
 		return nil, fmt.Errorf("error setting `name` for data federated instance (%s): %s", d.Id(), err)
 	}
 
+	tenant, err := getDataFederationTenant(ctx, meta.(*MongoDBClient).Atlas, projectID, name)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't import data federated instance (%s) for project (%s), error: %s", name, projectID, err)
+	}
+
 	if val, ok := dataFederationInstance.GetCloudProviderConfigOk(); ok {
-		if cloudProviderField := flattenCloudProviderConfig(d, val); cloudProviderField != nil {
+		cloudProviderField := flattenCloudProviderConfig(d, val)
+		if val.Aws.GetRoleId() == "" {
+			cloudProviderField = flattenDataFederationCloudProviderConfig(tenant.CloudProviderConfig)
+		}
+
+		if cloudProviderField != nil {
 			if err = d.Set("cloud_provider_config", cloudProviderField); err != nil {
 				return nil, fmt.Errorf(errorFederatedDatabaseInstanceSetting, "cloud_provider_config", name, err)
 			}
@@ -501,7 +634,7 @@ func resourceMongoDBAtlasFederatedDatabaseInstanceImportState(ctx context.Contex
 		}
 
 		if stores, ok := storage.GetStoresOk(); ok {
-			if err := d.Set("storage_stores", flattenDataFederationStores(stores)); err != nil {
+			if err := d.Set("storage_stores", addDataFederationStoresAzureFields(flattenDataFederationStores(stores), tenant.Storage)); err != nil {
 				return nil, fmt.Errorf(errorFederatedDatabaseInstanceSetting, "storage_stores", name, err)
 			}
 		}
@@ -689,14 +822,20 @@ func newUrls(urlsFromConfig []interface{}) []string {
 
 func newCloudProviderConfig(d *schema.ResourceData) *admin.DataLakeCloudProviderConfig {
 	if cloudProvider, ok := d.Get("cloud_provider_config").([]interface{}); ok && len(cloudProvider) == 1 {
-		return admin.NewDataLakeCloudProviderConfig(*newAWSConfig(cloudProvider))
+		// Azure and GCP are sent by sendDataFederationTenant
+		if awsConfig := newAWSConfig(cloudProvider); awsConfig != nil {
+			return admin.NewDataLakeCloudProviderConfig(*awsConfig)
+		}
 	}
 
 	return nil
 }
 
 func newAWSConfig(cloudProvider []interface{}) *admin.DataLakeAWSCloudProviderConfig {
-	if aws, ok := cloudProvider[0].(map[string]interface{})["aws"].([]interface{}); ok && len(aws) == 1 {
+	if cloudProvider[0] == nil {
+		return nil
+	}
+	if aws, ok := cloudProvider[0].(map[string]interface{})["aws"].([]interface{}); ok && len(aws) == 1 && aws[0] != nil {
 		awsSchema := aws[0].(map[string]interface{})
 		return admin.NewDataLakeAWSCloudProviderConfig(awsSchema["role_id"].(string), awsSchema["test_s3_bucket"].(string))
 	}
@@ -754,6 +893,217 @@ func flattenCloudProviderConfig(d *schema.ResourceData, cloudProviderConfig *adm
 	return awsOut
 }
 
+// newDataFederationTenant returns the Azure and GCP configuration of the instance, or nil when it only uses
+// settings supported by the admin client.
+func newDataFederationTenant(d *schema.ResourceData) *dataFederationTenant {
+	tenant := &dataFederationTenant{}
+
+	if roleID, ok := d.GetOk("cloud_provider_config.0.azure.0.role_id"); ok {
+		tenant.CloudProviderConfig = &dataFederationCloudProviderConfig{
+			Azure: &dataFederationAzureConfig{RoleID: roleID.(string)},
+		}
+	}
+	if roleID, ok := d.GetOk("cloud_provider_config.0.gcp.0.role_id"); ok {
+		tenant.CloudProviderConfig = &dataFederationCloudProviderConfig{
+			GCP: &dataFederationGCPConfig{RoleID: roleID.(string)},
+		}
+	}
+
+	var hasAzureStores bool
+	storage := &dataFederationStorage{}
+	for _, storeFromConf := range d.Get("storage_stores").(*schema.Set).List() {
+		storeFromConfMap := storeFromConf.(map[string]interface{})
+		store := dataFederationStore{
+			Name:                 storeFromConfMap["name"].(string),
+			ServiceURL:           storeFromConfMap["service_url"].(string),
+			ContainerName:        storeFromConfMap["container_name"].(string),
+			ReplacementDelimiter: storeFromConfMap["replacement_delimiter"].(string),
+		}
+		if store.ServiceURL != "" || store.ContainerName != "" || store.ReplacementDelimiter != "" {
+			hasAzureStores = true
+		}
+		storage.Stores = append(storage.Stores, store)
+	}
+	if hasAzureStores {
+		tenant.Storage = storage
+	}
+
+	if tenant.CloudProviderConfig == nil && tenant.Storage == nil {
+		return nil
+	}
+
+	return tenant
+}
+
+// sendDataFederationTenant sends the instance with the Azure and GCP configuration added to the request of the
+// admin client.
+func sendDataFederationTenant(ctx context.Context, conn *matlas.Client, method, path string, dataLakeTenant *admin.DataLakeTenant, tenant *dataFederationTenant) error {
+	tenantJSON, err := json.Marshal(dataLakeTenant)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(tenantJSON, &body); err != nil {
+		return err
+	}
+
+	if tenant.CloudProviderConfig != nil {
+		body["cloudProviderConfig"] = tenant.CloudProviderConfig
+	}
+
+	if tenant.Storage != nil {
+		if storage, ok := body["storage"].(map[string]interface{}); ok {
+			stores, _ := storage["stores"].([]interface{})
+			for _, store := range stores {
+				addDataFederationStoreAzureFields(store.(map[string]interface{}), tenant.Storage)
+			}
+		}
+	}
+
+	_, err := doAtlasAPIV2Request(ctx, conn, method, path, atlasAPIV2Version20230101, body, nil)
+	return err
+}
+
+func addDataFederationStoreAzureFields(store map[string]interface{}, storage *dataFederationStorage) {
+	for i := range storage.Stores {
+		if storage.Stores[i].Name != store["name"] {
+			continue
+		}
+		if storage.Stores[i].ServiceURL != "" {
+			store["serviceURL"] = storage.Stores[i].ServiceURL
+		}
+		if storage.Stores[i].ContainerName != "" {
+			store["containerName"] = storage.Stores[i].ContainerName
+		}
+		if storage.Stores[i].ReplacementDelimiter != "" {
+			store["replacementDelimiter"] = storage.Stores[i].ReplacementDelimiter
+		}
+	}
+}
+
+func getDataFederationTenant(ctx context.Context, conn *matlas.Client, projectID, name string) (*dataFederationTenant, error) {
+	path := fmt.Sprintf("%s/%s", fmt.Sprintf(dataFederationPath, projectID), name)
+	tenant := new(dataFederationTenant)
+	if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodGet, path, atlasAPIV2Version20230101, nil, tenant); err != nil {
+		return nil, err
+	}
+
+	return tenant, nil
+}
+
+// validateDataFederationRole checks that the cloud provider access role of the instance exists in the project, as
+// Atlas only reports a missing role once it queries the storage. Roles that are only known after apply, such as
+// roles created in the same configuration, and roles that don't change aren't checked.
+func validateDataFederationRole(ctx context.Context, conn *matlas.Client, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("project_id") {
+		return nil
+	}
+	projectID := d.Get("project_id").(string)
+
+	for _, provider := range []string{"aws", "azure", "gcp"} {
+		attr := fmt.Sprintf("cloud_provider_config.0.%s.0.role_id", provider)
+		roleID, ok := d.GetOk(attr)
+		if !ok || !d.NewValueKnown(attr) || !d.HasChange(attr) {
+			continue
+		}
+
+		if _, resp, err := conn.CloudProviderAccess.GetRole(ctx, projectID, roleID.(string)); err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("the %s cloud provider access role (%s) doesn't exist in project (%s)", strings.ToUpper(provider), roleID, projectID)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenDataFederationCloudProviderConfig(cloudProviderConfig *dataFederationCloudProviderConfig) []map[string]interface{} {
+	switch {
+	case cloudProviderConfig == nil:
+		return nil
+	case cloudProviderConfig.Azure != nil:
+		return []map[string]interface{}{
+			{
+				"azure": []map[string]interface{}{
+					{
+						"role_id":              cloudProviderConfig.Azure.RoleID,
+						"atlas_app_id":         cloudProviderConfig.Azure.AtlasAppID,
+						"service_principal_id": cloudProviderConfig.Azure.ServicePrincipalID,
+						"tenant_id":            cloudProviderConfig.Azure.TenantID,
+					},
+				},
+			},
+		}
+	case cloudProviderConfig.GCP != nil:
+		return []map[string]interface{}{
+			{
+				"gcp": []map[string]interface{}{
+					{
+						"role_id":             cloudProviderConfig.GCP.RoleID,
+						"gcp_service_account": cloudProviderConfig.GCP.GCPServiceAccount,
+					},
+				},
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+// addDataFederationStoresAzureFields adds the Azure fields of the raw response to the flattened stores.
+func addDataFederationStoresAzureFields(stores []map[string]interface{}, storage *dataFederationStorage) []map[string]interface{} {
+	if storage == nil {
+		return stores
+	}
+
+	for _, store := range stores {
+		for i := range storage.Stores {
+			if storage.Stores[i].Name == store["name"] {
+				store["service_url"] = storage.Stores[i].ServiceURL
+				store["container_name"] = storage.Stores[i].ContainerName
+				store["replacement_delimiter"] = storage.Stores[i].ReplacementDelimiter
+			}
+		}
+	}
+
+	return stores
+}
+
+// resourceFederatedDatabaseInstanceCustomizeDiff checks that the cloud provider access role exists and that the Azure
+// and GCS stores have the fields Atlas requires, which depend on the provider of each store.
+func resourceFederatedDatabaseInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateDataFederationRole(ctx, meta.(*MongoDBClient).Atlas, d); err != nil {
+		return err
+	}
+
+	// the stores can reference attributes of other resources that are only known after apply
+	stores, ok := d.Get("storage_stores").(*schema.Set)
+	if !ok || !d.NewValueKnown("storage_stores") {
+		return nil
+	}
+
+	for _, storeFromConf := range stores.List() {
+		store := storeFromConf.(map[string]interface{})
+		var required []string
+		switch store["provider"].(string) {
+		case dataFederationStoreProviderAzure:
+			required = []string{"service_url", "container_name", "region"}
+		case dataFederationStoreProviderGCS:
+			required = []string{"bucket", "region"}
+		}
+
+		for _, attr := range required {
+			if store[attr].(string) == "" {
+				return fmt.Errorf("`%s` is required for the %s store %q", attr, store["provider"], store["name"])
+			}
+		}
+	}
+
+	return nil
+}
+
 func flattenDataProcessRegion(processRegion *admin.DataLakeDataProcessRegion) []map[string]interface{} {
 	if processRegion == nil || (processRegion.Region != "" && processRegion.CloudProvider != "") {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -100,6 +101,31 @@ func TestAccFederatedDatabaseInstance_S3bucket(t *testing.T) {
 	})
 }
 
+func TestAccFederatedDatabaseInstance_azureAndGCPValidation(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc")
+		name        = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasFederatedDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasFederatedDatabaseInstanceConfigAzure(projectName, orgID, name, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`container_name` is required for the azure store"),
+			},
+			{
+				Config:      testAccMongoDBAtlasFederatedDatabaseInstanceConfigAzure(projectName, orgID, name, "container"),
+				ExpectError: regexp.MustCompile("the AZURE cloud provider access role \\(000000000000000000000000\\) doesn't exist"),
+			},
+		},
+	})
+}
+
 func TestAccFederatedDatabaseInstance_atlasCluster(t *testing.T) {
 	var (
 		resourceName = "mongodbatlas_federated_database_instance.test"
@@ -349,6 +375,46 @@ resource "mongodbatlas_cloud_provider_access_authorization" "auth_role" {
 %s
 	`, policyName, roleName, projectName, orgID, stepConfig)
 }
+func testAccMongoDBAtlasFederatedDatabaseInstanceConfigAzure(projectName, orgID, name, containerName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  name   = %[1]q
+  org_id = %[2]q
+}
+
+resource "mongodbatlas_federated_database_instance" "test" {
+  project_id = mongodbatlas_project.test.id
+  name       = %[3]q
+
+  cloud_provider_config {
+    azure {
+      role_id = "000000000000000000000000"
+    }
+  }
+
+  storage_databases {
+    name = "VirtualDatabase0"
+    collections {
+      name = "VirtualCollection0"
+      data_sources {
+        store_name = "azure-store"
+        path       = "/{fileName string}"
+      }
+    }
+  }
+
+  storage_stores {
+    name           = "azure-store"
+    provider       = "azure"
+    region         = "US_EAST_2"
+    service_url    = "https://mongodbatlas.blob.core.windows.net/"
+    container_name = %[4]q
+    delimiter      = "/"
+  }
+}
+`, projectName, orgID, name, containerName)
+}
+
 func testAccMongoDBAtlasFederatedDatabaseInstanceConfigFirstSteps3Bucket(name, testS3Bucket string) string {
 	return fmt.Sprintf(`
 resource "mongodbatlas_federated_database_instance" "test" {
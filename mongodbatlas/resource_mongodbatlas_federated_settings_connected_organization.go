@@ -9,12 +9,22 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+const federatedSettingsOrgConfigPath = "api/atlas/v1.0/federationSettings/%s/connectedOrgConfigs/%s"
+
+// federatedSettingsOrgConfig adds the data access identity providers that the atlas client doesn't model yet.
+type federatedSettingsOrgConfig struct {
+	*matlas.FederatedSettingsConnectedOrganization
+	DataAccessIdentityProviderIDs *[]string `json:"dataAccessIdentityProviderIds,omitempty"`
+}
+
 func resourceMongoDBAtlasFederatedSettingsOrganizationConfig() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead,
+		CreateContext: resourceMongoDBAtlasFederatedSettingsOrganizationConfigCreate,
 		ReadContext:   resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead,
 		UpdateContext: resourceMongoDBAtlasFederatedSettingsOrganizationConfigUpdate,
 		DeleteContext: resourceMongoDBAtlasFederatedSettingsOrganizationConfigDelete,
@@ -25,10 +35,12 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfig() *schema.Resource
 			"federation_settings_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"org_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"identity_provider_id": {
 				Type:     schema.TypeString,
@@ -44,6 +56,22 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfig() *schema.Resource
 			"post_auth_role_grants": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"ORG_OWNER",
+						"ORG_GROUP_CREATOR",
+						"ORG_BILLING_ADMIN",
+						"ORG_BILLING_READ_ONLY",
+						"ORG_READ_ONLY",
+						"ORG_MEMBER",
+					}, false),
+				},
+			},
+			"data_access_identity_provider_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -56,6 +84,39 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfig() *schema.Resource
 	}
 }
 
+func resourceMongoDBAtlasFederatedSettingsOrganizationConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	federationSettingsID := d.Get("federation_settings_id").(string)
+	orgID := d.Get("org_id").(string)
+
+	orgConfig, _, err := getFederatedSettingsOrgConfig(ctx, conn, federationSettingsID, orgID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retreiving federation settings connected organization (%s): %s", orgID, err))
+	}
+
+	domainRestrictionEnabled := d.Get("domain_restriction_enabled").(bool)
+	orgConfig.DomainRestrictionEnabled = &domainRestrictionEnabled
+	orgConfig.IdentityProviderID = d.Get("identity_provider_id").(string)
+	orgConfig.DomainAllowList = cast.ToStringSlice(d.Get("domain_allow_list"))
+	orgConfig.PostAuthRoleGrants = cast.ToStringSlice(d.Get("post_auth_role_grants"))
+	if v, ok := d.GetOk("data_access_identity_provider_ids"); ok {
+		dataAccessIdentityProviderIDs := cast.ToStringSlice(v.(*schema.Set).List())
+		orgConfig.DataAccessIdentityProviderIDs = &dataAccessIdentityProviderIDs
+	}
+
+	if err := updateFederatedSettingsOrgConfig(ctx, conn, federationSettingsID, orgID, orgConfig); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating federation settings connected organization (%s): %s", orgID, err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"federation_settings_id": federationSettingsID,
+		"org_id":                 orgID,
+	}))
+
+	return resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead(ctx, d, meta)
+}
+
 func resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -68,13 +129,12 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead(ctx context.Con
 	federationSettingsID := ids["federation_settings_id"]
 	orgID := ids["org_id"]
 
-	federatedSettingsConnectedOrganization, resp, err := conn.FederatedSettings.GetConnectedOrg(context.Background(), federationSettingsID, orgID)
+	federatedSettingsConnectedOrganization, resp, err := getFederatedSettingsOrgConfig(ctx, conn, federationSettingsID, orgID)
 	if err != nil {
 		// case 404
 		// deleted in the backend case
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_federated_settings_org_config")
 		}
 
 		return diag.FromErr(fmt.Errorf("error getting federated settings organization config: %s", err))
@@ -92,6 +152,18 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead(ctx context.Con
 		return diag.FromErr(fmt.Errorf("error setting post_auth_role_grants (%s): %s", d.Id(), err))
 	}
 
+	var dataAccessIdentityProviderIDs []string
+	if federatedSettingsConnectedOrganization.DataAccessIdentityProviderIDs != nil {
+		dataAccessIdentityProviderIDs = *federatedSettingsConnectedOrganization.DataAccessIdentityProviderIDs
+	}
+	if err := d.Set("data_access_identity_provider_ids", dataAccessIdentityProviderIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting data_access_identity_provider_ids (%s): %s", d.Id(), err))
+	}
+
+	if err := d.Set("identity_provider_id", federatedSettingsConnectedOrganization.IdentityProviderID); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting identity provider id (%s): %s", d.Id(), err))
+	}
+
 	d.SetId(encodeStateID(map[string]string{
 		"federation_settings_id": federationSettingsID,
 		"org_id":                 orgID,
@@ -107,7 +179,7 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfigUpdate(ctx context.C
 	federationSettingsID := ids["federation_settings_id"]
 	orgID := ids["org_id"]
 
-	federatedSettingsConnectedOrganizationUpdate, _, err := conn.FederatedSettings.GetConnectedOrg(context.Background(), federationSettingsID, orgID)
+	federatedSettingsConnectedOrganizationUpdate, _, err := getFederatedSettingsOrgConfig(ctx, conn, federationSettingsID, orgID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error retreiving federation settings connected organization (%s): %s", federationSettingsID, err))
 	}
@@ -132,7 +204,12 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfigUpdate(ctx context.C
 		federatedSettingsConnectedOrganizationUpdate.PostAuthRoleGrants = cast.ToStringSlice(postAuthRoleGrants)
 	}
 
-	_, _, err = conn.FederatedSettings.UpdateConnectedOrg(ctx, federationSettingsID, orgID, federatedSettingsConnectedOrganizationUpdate)
+	if d.HasChange("data_access_identity_provider_ids") {
+		dataAccessIdentityProviderIDs := cast.ToStringSlice(d.Get("data_access_identity_provider_ids").(*schema.Set).List())
+		federatedSettingsConnectedOrganizationUpdate.DataAccessIdentityProviderIDs = &dataAccessIdentityProviderIDs
+	}
+
+	err = updateFederatedSettingsOrgConfig(ctx, conn, federationSettingsID, orgID, federatedSettingsConnectedOrganizationUpdate)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating federation settings connected organization (%s): %s", federationSettingsID, err))
 	}
@@ -179,6 +256,10 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfigImportState(ctx cont
 		return nil, fmt.Errorf("error setting domain allow list (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("post_auth_role_grants", federatedSettingsConnectedOrganization.PostAuthRoleGrants); err != nil {
+		return nil, fmt.Errorf("error setting post_auth_role_grants (%s): %s", d.Id(), err)
+	}
+
 	if err := d.Set("org_id", federatedSettingsConnectedOrganization.OrgID); err != nil {
 		return nil, fmt.Errorf("error setting org id (%s): %s", d.Id(), err)
 	}
@@ -209,3 +290,28 @@ func splitFederatedSettingsOrganizationConfigImportID(id string) (federationSett
 
 	return
 }
+
+func getFederatedSettingsOrgConfig(ctx context.Context, conn *matlas.Client, federationSettingsID, orgID string) (*federatedSettingsOrgConfig, *matlas.Response, error) {
+	req, err := conn.NewRequest(ctx, http.MethodGet, fmt.Sprintf(federatedSettingsOrgConfigPath, federationSettingsID, orgID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := &federatedSettingsOrgConfig{FederatedSettingsConnectedOrganization: &matlas.FederatedSettingsConnectedOrganization{}}
+	resp, err := conn.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+func updateFederatedSettingsOrgConfig(ctx context.Context, conn *matlas.Client, federationSettingsID, orgID string, orgConfig *federatedSettingsOrgConfig) error {
+	req, err := conn.NewRequest(ctx, http.MethodPatch, fmt.Sprintf(federatedSettingsOrgConfigPath, federationSettingsID, orgID), orgConfig)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do(ctx, req, nil)
+	return err
+}
@@ -72,6 +72,42 @@ func TestAccFedRSFederatedSettingsOrganizationConfig_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccFedRSFederatedSettingsOrganizationConfig_postAuthRoleGrants(t *testing.T) {
+	SkipTestExtCred(t)
+	var (
+		federatedSettingsIdentityProvider matlas.FederatedSettingsConnectedOrganization
+		resourceName                      = "mongodbatlas_federated_settings_org_config.test"
+		federationSettingsID              = os.Getenv("MONGODB_ATLAS_FEDERATION_SETTINGS_ID")
+		orgID                             = os.Getenv("MONGODB_ATLAS_FEDERATED_ORG_ID")
+		idpID                             = os.Getenv("MONGODB_ATLAS_FEDERATED_IDP_ID")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testCheckFederatedSettings(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasFederatedSettingsOrganizationConfigWithRoleGrants(federationSettingsID, orgID, idpID, "ORG_MEMBER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasFederatedSettingsOrganizationConfigRExists(resourceName, &federatedSettingsIdentityProvider),
+					resource.TestCheckResourceAttr(resourceName, "domain_restriction_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "domain_allow_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "post_auth_role_grants.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "post_auth_role_grants.0", "ORG_MEMBER"),
+					resource.TestCheckResourceAttrSet(resourceName, "data_access_identity_provider_ids.#"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasFederatedSettingsOrganizationConfigWithRoleGrants(federationSettingsID, orgID, idpID, "ORG_READ_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasFederatedSettingsOrganizationConfigRExists(resourceName, &federatedSettingsIdentityProvider),
+					resource.TestCheckResourceAttr(resourceName, "post_auth_role_grants.0", "ORG_READ_ONLY"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMongoDBAtlasFederatedSettingsOrganizationConfigRExists(resourceName string,
 	federatedSettingsIdentityProvider *matlas.FederatedSettingsConnectedOrganization) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -120,3 +156,15 @@ func testAccMongoDBAtlasFederatedSettingsOrganizationConfig(federationSettingsID
 		identity_provider_id = "%[3]s"
 	  }`, federationSettingsID, orgID, identityProviderID)
 }
+
+func testAccMongoDBAtlasFederatedSettingsOrganizationConfigWithRoleGrants(federationSettingsID, orgID, identityProviderID, role string) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_federated_settings_org_config" "test" {
+		federation_settings_id     = "%[1]s"
+		org_id                     = "%[2]s"
+		identity_provider_id       = "%[3]s"
+		domain_restriction_enabled = true
+		domain_allow_list          = ["reorganizeyourworld.com"]
+		post_auth_role_grants      = ["%[4]s"]
+	  }`, federationSettingsID, orgID, identityProviderID, role)
+}
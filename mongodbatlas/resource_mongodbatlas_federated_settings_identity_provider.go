@@ -87,8 +87,7 @@ func resourceMongoDBAtlasFederatedSettingsIdentityProviderRead(ctx context.Conte
 		// case 404
 		// deleted in the backend case
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_federated_settings_identity_provider")
 		}
 
 		return diag.FromErr(fmt.Errorf("error getting federated settings identity provider: %s", err))
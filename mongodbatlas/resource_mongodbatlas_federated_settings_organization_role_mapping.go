@@ -83,8 +83,7 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationRoleMappingRead(ctx contex
 		// case 404
 		// deleted in the backend case
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_federated_settings_org_role_mapping")
 		}
 
 		return diag.FromErr(fmt.Errorf("error getting federated settings organization config: %s", err))
@@ -177,8 +177,7 @@ func resourceMongoDBAtlasGlobalClusterRead(ctx context.Context, d *schema.Resour
 	globalCluster, resp, err := conn.GlobalClusters.Get(ctx, projectID, clusterName)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_global_cluster_config")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorGlobalClusterRead, clusterName, err))
@@ -1,9 +1,16 @@
 package mongodbatlas
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
 	"time"
@@ -19,8 +26,17 @@ const (
 	errorLDAPVerifyCreate  = "error creating MongoDB LDAPVerify (%s): %s"
 	errorLDAPVerifyRead    = "error reading MongoDB LDAPVerify (%s): %s"
 	errorLDAPVerifySetting = "error setting `%s` for LDAPVerify(%s): %s"
+	errorLDAPVerifyFailed  = "LDAP verification (%s) failed, validations that didn't succeed: %s"
+	ldapVerifyStatusPath   = "api/atlas/v1.0/groups/%s/userSecurity/ldap/verify/%s"
 )
 
+// ldapVerifyRequestCertificate holds the CA certificate returned with an LDAP verification.
+type ldapVerifyRequestCertificate struct {
+	Request struct {
+		CaCertificate string `json:"caCertificate,omitempty"`
+	} `json:"request,omitempty"`
+}
+
 func resourceMongoDBAtlasLDAPVerify() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasLDAPVerifyCreate,
@@ -29,6 +45,9 @@ func resourceMongoDBAtlasLDAPVerify() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasLDAPVerifyImportState,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -99,6 +118,51 @@ func resourceMongoDBAtlasLDAPVerify() *schema.Resource {
 					},
 				},
 			},
+			"validation_status": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"server_certificate_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_before": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sha256_fingerprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"fail_on_validation_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -145,17 +209,22 @@ func resourceMongoDBAtlasLDAPVerifyCreate(ctx context.Context, d *schema.Resourc
 		Pending:    []string{"PENDING"},
 		Target:     []string{"SUCCESS", "FAILED"},
 		Refresh:    resourceLDAPGetStatusRefreshFunc(ctx, projectID, ldap.RequestID, conn),
-		Timeout:    3 * time.Hour,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 1 * time.Minute,
 		Delay:      3 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	result, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorLDAPVerifyCreate, projectID, err))
 	}
 
+	// Failing here keeps the resource out of the state, so anything depending on it isn't applied.
+	if verify, ok := result.(*matlas.LDAPConfiguration); ok && verify.Status == "FAILED" && d.Get("fail_on_validation_error").(bool) {
+		return diag.FromErr(fmt.Errorf(errorLDAPVerifyFailed, ldap.RequestID, strings.Join(failedLDAPValidations(verify.Validations), ", ")))
+	}
+
 	d.SetId(encodeStateID(map[string]string{
 		"project_id": projectID,
 		"request_id": ldap.RequestID,
@@ -171,11 +240,10 @@ func resourceMongoDBAtlasLDAPVerifyRead(ctx context.Context, d *schema.ResourceD
 	projectID := ids["project_id"]
 	requestID := ids["request_id"]
 
-	ldapResp, resp, err := conn.LDAPConfigurations.GetStatus(context.Background(), projectID, requestID)
+	ldapResp, caCertificate, resp, err := getLDAPVerifyStatus(ctx, conn, projectID, requestID)
 	if err != nil || ldapResp == nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_ldap_verify")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorLDAPVerifyRead, d.Id(), err))
@@ -196,6 +264,16 @@ func resourceMongoDBAtlasLDAPVerifyRead(ctx context.Context, d *schema.ResourceD
 	if err := d.Set("validations", flattenValidations(ldapResp.Validations)); err != nil {
 		return diag.FromErr(fmt.Errorf(errorLDAPVerifySetting, "validations", d.Id(), err))
 	}
+	if err := d.Set("validation_status", flattenValidationStatus(ldapResp.Validations)); err != nil {
+		return diag.FromErr(fmt.Errorf(errorLDAPVerifySetting, "validation_status", d.Id(), err))
+	}
+	cert, err := parseCertificatePEM(caCertificate)
+	if err != nil {
+		log.Printf("[WARN] unable to parse the CA certificate of LDAP verification %s: %s", requestID, err)
+	}
+	if err := d.Set("server_certificate_details", flattenCertificateDetails(cert)); err != nil {
+		return diag.FromErr(fmt.Errorf(errorLDAPVerifySetting, "server_certificate_details", d.Id(), err))
+	}
 	if err := d.Set("request_id", ldapResp.RequestID); err != nil {
 		return diag.FromErr(fmt.Errorf(errorLDAPVerifySetting, "request_id", d.Id(), err))
 	}
@@ -236,6 +314,85 @@ func flattenValidations(validationsArray []*matlas.LDAPValidation) []map[string]
 	return validations
 }
 
+func flattenValidationStatus(validationsArray []*matlas.LDAPValidation) map[string]interface{} {
+	validationStatus := make(map[string]interface{}, len(validationsArray))
+	for _, v := range validationsArray {
+		validationStatus[v.ValidationType] = v.Status
+	}
+
+	return validationStatus
+}
+
+func failedLDAPValidations(validationsArray []*matlas.LDAPValidation) []string {
+	var failed []string
+	for _, v := range validationsArray {
+		if v.Status != "OK" {
+			failed = append(failed, fmt.Sprintf("%s (%s)", v.ValidationType, v.Status))
+		}
+	}
+
+	return failed
+}
+
+// getLDAPVerifyStatus gets the status of an LDAP verification along with the CA certificate Atlas used to verify the
+// TLS certificate of the server, which matlas.LDAPRequest has no field for. Both are decoded from the same response.
+func getLDAPVerifyStatus(ctx context.Context, conn *matlas.Client, projectID, requestID string) (*matlas.LDAPConfiguration, string, *matlas.Response, error) {
+	req, err := conn.NewRequest(ctx, http.MethodGet, fmt.Sprintf(ldapVerifyStatusPath, projectID, requestID), nil)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	body := new(bytes.Buffer)
+	resp, err := conn.Do(ctx, req, body)
+	if err != nil {
+		return nil, "", resp, err
+	}
+
+	ldapResp := new(matlas.LDAPConfiguration)
+	if err := json.Unmarshal(body.Bytes(), ldapResp); err != nil {
+		return nil, "", resp, err
+	}
+	root := new(ldapVerifyRequestCertificate)
+	if err := json.Unmarshal(body.Bytes(), root); err != nil {
+		return nil, "", resp, err
+	}
+
+	return ldapResp, root.Request.CaCertificate, resp, nil
+}
+
+// parseCertificatePEM returns the first certificate of a PEM bundle, or nil if the bundle is empty.
+func parseCertificatePEM(certificate string) (*x509.Certificate, error) {
+	if certificate == "" {
+		return nil, nil
+	}
+
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return nil, errors.New("no PEM certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func flattenCertificateDetails(cert *x509.Certificate) []map[string]interface{} {
+	if cert == nil {
+		return nil
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	return []map[string]interface{}{
+		{
+			"subject":            cert.Subject.String(),
+			"issuer":             cert.Issuer.String(),
+			"serial_number":      cert.SerialNumber.String(),
+			"not_before":         cert.NotBefore.UTC().Format(time.RFC3339),
+			"not_after":          cert.NotAfter.UTC().Format(time.RFC3339),
+			"sha256_fingerprint": hex.EncodeToString(fingerprint[:]),
+		},
+	}
+}
+
 func resourceMongoDBAtlasLDAPVerifyImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*MongoDBClient).Atlas
 
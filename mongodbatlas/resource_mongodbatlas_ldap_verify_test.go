@@ -44,6 +44,7 @@ func TestAccAdvRSLDAPVerify_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "bind_username"),
 					resource.TestCheckResourceAttrSet(resourceName, "request_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "port"),
+					resource.TestCheckResourceAttrSet(resourceName, "validation_status.%"),
 				),
 			},
 		},
@@ -87,6 +88,9 @@ func TestAccAdvRSLDAPVerifyWithConfiguration_CACertificate(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "validations.1.status", "OK"),
 					resource.TestCheckResourceAttr(resourceName, "validations.2.validation_type", "AUTHENTICATE"),
 					resource.TestCheckResourceAttr(resourceName, "validations.2.status", "OK"),
+					resource.TestCheckResourceAttr(resourceName, "validation_status.AUTHENTICATE", "OK"),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_details.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "server_certificate_details.0.not_after"),
 				),
 			},
 		},
@@ -242,6 +246,7 @@ func testAccMongoDBAtlasLDAPVerifyWithConfigurationConfig(projectName, orgID, cl
 			ca_certificate = <<-EOF
 %[9]s
 			EOF
+			fail_on_validation_error = true
 			depends_on = [mongodbatlas_cluster.test]
 		}
 
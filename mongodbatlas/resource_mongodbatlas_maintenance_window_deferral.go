@@ -0,0 +1,123 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	errorMaintenanceDeferralCreate  = "error deferring the MongoDB Atlas Maintenance Window (%s): %s"
+	errorMaintenanceDeferralRead    = "error reading the MongoDB Atlas Maintenance Window (%s): %s"
+	errorMaintenanceDeferralSetting = "error setting `%s` for MongoDB Atlas Maintenance Window deferral (%s): %s"
+)
+
+// resourceMongoDBAtlasMaintenanceWindowDeferral defers the scheduled maintenance of a project once, when it's
+// created or when any of its `triggers` change, so pipelines can postpone a maintenance without managing the
+// maintenance window itself. Destroying it doesn't undo the deferral.
+func resourceMongoDBAtlasMaintenanceWindowDeferral() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasMaintenanceWindowDeferralCreate,
+		ReadContext:   resourceMongoDBAtlasMaintenanceWindowDeferralRead,
+		DeleteContext: resourceMongoDBAtlasMaintenanceWindowDeferralDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deferred_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_of_deferrals": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasMaintenanceWindowDeferralCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get the client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+
+	resp, err := conn.MaintenanceWindows.Defer(ctx, projectID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorMaintenanceDeferralCreate, projectID, err))
+	}
+	deferredAt := maintenanceDeferralTime(resp).UTC().Format(time.RFC3339)
+
+	// the number of deferrals is only read after the deferral, so it includes this one
+	maintenanceWindow, _, err := conn.MaintenanceWindows.Get(ctx, projectID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorMaintenanceDeferralRead, projectID, err))
+	}
+
+	values := map[string]interface{}{
+		"deferred_at":         deferredAt,
+		"number_of_deferrals": maintenanceWindow.NumberOfDeferrals,
+	}
+	for attr, value := range values {
+		if err := d.Set(attr, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorMaintenanceDeferralSetting, attr, projectID, err))
+		}
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":  projectID,
+		"deferred_at": deferredAt,
+	}))
+
+	return nil
+}
+
+func resourceMongoDBAtlasMaintenanceWindowDeferralRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get the client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := decodeStateID(d.Id())["project_id"]
+
+	// The attributes record the deferral itself, so they aren't refreshed. The deferral is only removed from the state
+	// when the project is gone.
+	_, resp, err := conn.MaintenanceWindows.Get(ctx, projectID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_maintenance_window_deferral")
+		}
+		return diag.FromErr(fmt.Errorf(errorMaintenanceDeferralRead, projectID, err))
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasMaintenanceWindowDeferralDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] removing the maintenance window deferral of project %s from the state, the deferral itself can't be undone", decodeStateID(d.Id())["project_id"])
+	d.SetId("")
+	return nil
+}
+
+// maintenanceDeferralTime returns when Atlas accepted the deferral, from the Date header of its response, so the time
+// doesn't depend on the clock of the machine running Terraform.
+func maintenanceDeferralTime(resp *matlas.Response) time.Time {
+	if resp != nil && resp.Response != nil {
+		if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+			return date
+		}
+	}
+	return time.Now()
+}
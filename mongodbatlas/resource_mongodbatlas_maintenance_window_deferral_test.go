@@ -0,0 +1,48 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigRSMaintenanceWindowDeferral_basic(t *testing.T) {
+	// deferring requires a project with a scheduled maintenance
+	SkipTestForCI(t)
+	var (
+		resourceName = "mongodbatlas_maintenance_window_deferral.test"
+		projectID    = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasMaintenanceWindowDeferralConfig(projectID, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
+					resource.TestCheckResourceAttrSet(resourceName, "deferred_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "number_of_deferrals"),
+				),
+			},
+			{
+				Config:             testAccMongoDBAtlasMaintenanceWindowDeferralConfig(projectID, "first"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasMaintenanceWindowDeferralConfig(projectID, trigger string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_maintenance_window_deferral" "test" {
+			project_id = %[1]q
+			triggers = {
+				release = %[2]q
+			}
+		}`, projectID, trigger)
+}
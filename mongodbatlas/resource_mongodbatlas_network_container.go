@@ -158,8 +158,7 @@ func resourceMongoDBAtlasNetworkContainerRead(ctx context.Context, d *schema.Res
 	container, resp, err := conn.Containers.Get(ctx, projectID, containerID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_network_container")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorContainerRead, containerID, err))
@@ -274,8 +274,7 @@ func resourceMongoDBAtlasNetworkPeeringRead(ctx context.Context, d *schema.Resou
 	peer, resp, err := conn.Peers.Get(ctx, projectID, peerID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_network_peering")
 		}
 
 		return diag.FromErr(fmt.Errorf(errorPeersRead, peerID, err))
@@ -0,0 +1,148 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mwielbut/pointy"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	errorOrgSettingsUpdate   = "error updating MongoDB Atlas Organization Settings (%s): %s"
+	errorOrgSettingsRead     = "error reading MongoDB Atlas Organization Settings (%s): %s"
+	errorOrgSettingsSetting  = "error setting `%s` for MongoDB Atlas Organization Settings (%s): %s"
+	orgSettingsAttrAPIAccess = "api_access_list_required"
+	orgSettingsAttrMFA       = "multi_factor_auth_required"
+	orgSettingsAttrEmployee  = "restrict_employee_access"
+)
+
+// resourceMongoDBAtlasOrgSettings manages the settings of an existing organization. There's a single settings object
+// per organization, so destroying the resource leaves the settings as they are.
+func resourceMongoDBAtlasOrgSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasOrgSettingsCreate,
+		ReadContext:   resourceMongoDBAtlasOrgSettingsRead,
+		UpdateContext: resourceMongoDBAtlasOrgSettingsUpdate,
+		DeleteContext: resourceMongoDBAtlasOrgSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMongoDBAtlasOrgSettingsImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			orgSettingsAttrAPIAccess: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			orgSettingsAttrMFA: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			orgSettingsAttrEmployee: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasOrgSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).AtlasV2
+	orgID := d.Get("org_id").(string)
+
+	// only the configured settings are changed, the others keep their current value
+	settings := &admin.OrganizationSettings{}
+	if v, ok := d.GetOkExists(orgSettingsAttrAPIAccess); ok {
+		settings.ApiAccessListRequired = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists(orgSettingsAttrMFA); ok {
+		settings.MultiFactorAuthRequired = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists(orgSettingsAttrEmployee); ok {
+		settings.RestrictEmployeeAccess = pointy.Bool(v.(bool))
+	}
+
+	if _, _, err := conn.OrganizationsApi.UpdateOrganizationSettings(ctx, orgID, settings).Execute(); err != nil {
+		return diag.FromErr(fmt.Errorf(errorOrgSettingsUpdate, orgID, err))
+	}
+
+	d.SetId(orgID)
+
+	return resourceMongoDBAtlasOrgSettingsRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasOrgSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get the client connection.
+	conn := meta.(*MongoDBClient).AtlasV2
+	orgID := d.Id()
+
+	settings, resp, err := conn.OrganizationsApi.GetOrganizationSettings(ctx, orgID).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(errorOrgSettingsRead, orgID, err))
+	}
+
+	values := map[string]interface{}{
+		"org_id":                 orgID,
+		orgSettingsAttrAPIAccess: settings.GetApiAccessListRequired(),
+		orgSettingsAttrMFA:       settings.GetMultiFactorAuthRequired(),
+		orgSettingsAttrEmployee:  settings.GetRestrictEmployeeAccess(),
+	}
+	for attr, value := range values {
+		if err = d.Set(attr, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorOrgSettingsSetting, attr, orgID, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasOrgSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).AtlasV2
+	orgID := d.Id()
+
+	settings := &admin.OrganizationSettings{}
+	if d.HasChange(orgSettingsAttrAPIAccess) {
+		settings.ApiAccessListRequired = pointy.Bool(d.Get(orgSettingsAttrAPIAccess).(bool))
+	}
+	if d.HasChange(orgSettingsAttrMFA) {
+		settings.MultiFactorAuthRequired = pointy.Bool(d.Get(orgSettingsAttrMFA).(bool))
+	}
+	if d.HasChange(orgSettingsAttrEmployee) {
+		settings.RestrictEmployeeAccess = pointy.Bool(d.Get(orgSettingsAttrEmployee).(bool))
+	}
+
+	if _, _, err := conn.OrganizationsApi.UpdateOrganizationSettings(ctx, orgID, settings).Execute(); err != nil {
+		return diag.FromErr(fmt.Errorf(errorOrgSettingsUpdate, orgID, err))
+	}
+
+	return resourceMongoDBAtlasOrgSettingsRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasOrgSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] removing the settings of organization %s from the state, the settings keep their current values", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceMongoDBAtlasOrgSettingsImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("org_id", d.Id()); err != nil {
+		return nil, fmt.Errorf(errorOrgSettingsSetting, "org_id", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
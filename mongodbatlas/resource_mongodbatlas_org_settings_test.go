@@ -0,0 +1,55 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigRSOrgSettings_basic(t *testing.T) {
+	// the settings apply to the whole organization, which is shared with the other tests
+	SkipTestForCI(t)
+	var (
+		resourceName = "mongodbatlas_org_settings.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasOrgSettingsConfig(orgID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "org_id", orgID),
+					resource.TestCheckResourceAttr(resourceName, "restrict_employee_access", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_access_list_required"),
+					resource.TestCheckResourceAttrSet(resourceName, "multi_factor_auth_required"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasOrgSettingsConfig(orgID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "org_id", orgID),
+					resource.TestCheckResourceAttr(resourceName, "restrict_employee_access", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     orgID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasOrgSettingsConfig(orgID string, restrictEmployeeAccess bool) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_org_settings" "test" {
+			org_id                   = %[1]q
+			restrict_employee_access = %[2]t
+		}`, orgID, restrictEmployeeAccess)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -85,10 +86,12 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessCreate(ctx context.Context
 		return diag.Errorf(errorServerlessServiceEndpointAdd, privateLinkRequest.CloudProviderEndpointID, err)
 	}
 
+	// The endpoint is RESERVED once Atlas has created the endpoint service, which the AWS VPC endpoint or the Azure
+	// private endpoint connects to, so wait until the provider specific attribute is set too.
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"RESERVATION_REQUESTED", "INITIATING", "DELETING"},
-		Target:     []string{"RESERVED", "FAILED", "DELETED", "AVAILABLE"},
-		Refresh:    resourcePrivateLinkEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endPoint.ID),
+		Pending:    []string{"RESERVATION_REQUESTED", "INITIATING"},
+		Target:     []string{"RESERVED", "AVAILABLE"},
+		Refresh:    resourcePrivateLinkEndpointServerlessReservedRefreshFunc(ctx, conn, projectID, instanceName, endPoint.ID, d.Get("provider_name").(string)),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 5 * time.Second,
 		Delay:      5 * time.Second,
@@ -97,7 +100,7 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessCreate(ctx context.Context
 	// Wait, catching any errors
 	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorServerlessEndpointAdd, err, endPoint.ID))
+		return diag.FromErr(fmt.Errorf(errorServerlessEndpointAdd, endPoint.ID, err))
 	}
 
 	d.SetId(encodeStateID(map[string]string{
@@ -122,8 +125,7 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessRead(ctx context.Context,
 		// case 404/ 400
 		// deleted in the backend case
 		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_privatelink_endpoint_serverless")
 		}
 
 		return diag.Errorf("error getting Serverless private link endpoint  information: %s", err)
@@ -177,9 +179,10 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessDelete(ctx context.Context
 		return diag.Errorf("error deleting serverless private link endpoint(%s): %s", endpointID, err)
 	}
 
+	// a FAILED endpoint is reported as an error by the refresh function, so only the deletion is waited for
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{"DELETING"},
-		Target:     []string{"DELETED", "FAILED"},
+		Target:     []string{"DELETED"},
 		Refresh:    resourcePrivateLinkEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endpointID),
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 5 * time.Second,
@@ -249,17 +252,37 @@ func resourcePrivateLinkEndpointServerlessRefreshFunc(ctx context.Context, clien
 	return func() (interface{}, string, error) {
 		p, resp, err := client.ServerlessPrivateEndpoints.Get(ctx, projectID, instanceName, privateLinkID)
 		if err != nil {
-			if resp.Response.StatusCode == 404 || resp.Response.StatusCode == 400 {
+			if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest) {
 				return "", "DELETED", nil
 			}
 
 			return nil, "REJECTED", err
 		}
 
-		if p.Status != "WAITING_FOR_USER" {
-			return "", p.Status, nil
+		if p.Status == "FAILED" {
+			return nil, p.Status, fmt.Errorf("serverless private link endpoint failed: %s", p.ErrorMessage)
 		}
 
 		return p, p.Status, nil
 	}
 }
+
+// resourcePrivateLinkEndpointServerlessReservedRefreshFunc reports a RESERVED endpoint as still pending until
+// the endpoint service name (AWS) or the private link service resource ID (AZURE) is available.
+func resourcePrivateLinkEndpointServerlessReservedRefreshFunc(ctx context.Context, client *matlas.Client, projectID, instanceName, privateLinkID, providerName string) retry.StateRefreshFunc {
+	refresh := resourcePrivateLinkEndpointServerlessRefreshFunc(ctx, client, projectID, instanceName, privateLinkID)
+
+	return func() (interface{}, string, error) {
+		result, status, err := refresh()
+		if err != nil || status != "RESERVED" {
+			return result, status, err
+		}
+
+		p := result.(*matlas.ServerlessPrivateEndpointConnection)
+		if (providerName == "AZURE" && p.PrivateLinkServiceResourceID == "") || (providerName != "AZURE" && p.EndpointServiceName == "") {
+			return p, "RESERVATION_REQUESTED", nil
+		}
+
+		return p, status, nil
+	}
+}
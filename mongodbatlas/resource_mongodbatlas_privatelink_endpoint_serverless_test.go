@@ -29,6 +29,8 @@ func TestAccServerlessPrivateLinkEndpoint_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMongoDBAtlasPrivateLinkEndpointServerlessExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "instance_name", instanceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "RESERVED"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_service_name"),
 				),
 			},
 		},
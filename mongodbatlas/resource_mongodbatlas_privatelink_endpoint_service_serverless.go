@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 const (
@@ -61,6 +60,10 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerless() *schema.Resource
 				ForceNew: true,
 				Computed: true,
 			},
+			"endpoint_service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"private_link_service_resource_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,10 +112,11 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerlessCreate(ctx context.
 		return diag.Errorf(errorServerlessServiceEndpointAdd, endpointID, err)
 	}
 
+	// the endpoint is RESERVED until Atlas accepts the connection of the cloud provider endpoint
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"RESERVATION_REQUESTED", "INITIATING", "DELETING"},
-		Target:     []string{"RESERVED", "FAILED", "DELETED", "AVAILABLE"},
-		Refresh:    resourceServiceEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endpointID),
+		Pending:    []string{"RESERVATION_REQUESTED", "RESERVED", "INITIATING"},
+		Target:     []string{"AVAILABLE"},
+		Refresh:    resourcePrivateLinkEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endpointID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 5 * time.Second,
 		Delay:      5 * time.Minute,
@@ -159,8 +163,7 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerlessRead(ctx context.Co
 		// case 404
 		// deleted in the backend case
 		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_privatelink_endpoint_service_serverless")
 		}
 
 		return diag.Errorf("error getting Serverless private link endpoint  information: %s", err)
@@ -192,6 +195,10 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerlessRead(ctx context.Co
 		return diag.Errorf("error setting `cloud_provider_endpoint_id` for endpoint_id (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("endpoint_service_name", privateLinkResponse.EndpointServiceName); err != nil {
+		return diag.Errorf("error setting `endpoint_service_name` for endpoint_id (%s): %s", d.Id(), err)
+	}
+
 	if err := d.Set("private_link_service_resource_id", privateLinkResponse.PrivateLinkServiceResourceID); err != nil {
 		return diag.Errorf("error setting `private_link_service_resource_id` for endpoint_id (%s): %s", d.Id(), err)
 	}
@@ -275,22 +282,3 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerlessImportState(ctx con
 
 	return []*schema.ResourceData{d}, nil
 }
-
-func resourceServiceEndpointServerlessRefreshFunc(ctx context.Context, client *matlas.Client, projectID, instanceName, endpointServiceID string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		i, resp, err := client.ServerlessPrivateEndpoints.Get(ctx, projectID, instanceName, endpointServiceID)
-		if err != nil {
-			if resp != nil && resp.StatusCode == 404 || resp.Response.StatusCode == 400 {
-				return "", "DELETED", nil
-			}
-
-			return nil, "", err
-		}
-
-		if i.Status != "AVAILABLE" {
-			return "", i.Status, nil
-		}
-
-		return i, i.Status, nil
-	}
-}
@@ -36,6 +36,7 @@ func TestAccServerlessPrivateLinkEndpointService_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(datasourceName, "comment", commentOrigin),
 					resource.TestCheckResourceAttrSet(datasourceEndpointsName, "project_id"),
 					resource.TestCheckResourceAttrSet(datasourceEndpointsName, "results.#"),
+					resource.TestCheckResourceAttrPair(datasourceEndpointsName, "results.0.endpoint_id", resourceName, "endpoint_id"),
 					resource.TestCheckResourceAttrSet(datasourceEndpointsName, "instance_name"),
 				),
 			},
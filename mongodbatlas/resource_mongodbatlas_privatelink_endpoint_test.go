@@ -2,15 +2,26 @@ package mongodbatlas
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("mongodbatlas_privatelink_endpoint", &resource.Sweeper{
+		Name: "mongodbatlas_privatelink_endpoint",
+		F:    testSweepPrivateLinkEndpoints,
+	})
+}
+
 func TestAccNetworkRSPrivateLinkEndpointAWS_basic(t *testing.T) {
 	var (
 		resourceName = "mongodbatlas_privatelink_endpoint.test"
@@ -240,3 +251,56 @@ func testAccMongoDBAtlasPrivateLinkEndpointConfigBasic(orgID, projectName, provi
 		}
 	`, orgID, projectName, providerName, region)
 }
+
+// testSweepPrivateLinkEndpoints deletes the private endpoint services of the projects created by the acceptance
+// tests. The services of the shared project can't be told apart, so they're left as they are.
+func testSweepPrivateLinkEndpoints(_ string) error {
+	client, orgID, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	conn := client.Atlas
+	projectIDs, err := sweepableProjectIDs(ctx, conn, orgID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for projectID, sweepAll := range projectIDs {
+		if !sweepAll {
+			continue
+		}
+
+		for _, providerName := range privateEndpointProviders {
+			privateEndpoints, _, err := conn.PrivateEndpoints.List(ctx, projectID, providerName, nil)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error listing the %s private endpoints of project %s: %s", providerName, projectID, err))
+				continue
+			}
+
+			for i := range privateEndpoints {
+				privateLinkID := privateEndpoints[i].ID
+				log.Printf("[INFO] sweeping %s private endpoint service %s of project %s", providerName, privateLinkID, projectID)
+				if _, err := conn.PrivateEndpoints.Delete(ctx, projectID, providerName, privateLinkID); err != nil {
+					errs = append(errs, fmt.Errorf("error deleting private endpoint service %s of project %s: %s", privateLinkID, projectID, err))
+					continue
+				}
+
+				stateConf := &retry.StateChangeConf{
+					Pending:    []string{"DELETING"},
+					Target:     []string{"DELETED", "FAILED"},
+					Refresh:    resourcePrivateLinkEndpointRefreshFunc(ctx, conn, projectID, providerName, privateLinkID),
+					Timeout:    1 * time.Hour,
+					MinTimeout: 5 * time.Second,
+				}
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					errs = append(errs, fmt.Errorf("error waiting for private endpoint service %s of project %s to be deleted: %s", privateLinkID, projectID, err))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -13,6 +13,22 @@ import (
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+const (
+	projectAPIKeysPath = "api/atlas/v1.0/groups/%s/apiKeys"
+)
+
+// projectAPIKey adds the audit metadata Atlas returns for an API key, which isn't mapped by the matlas client.
+type projectAPIKey struct {
+	matlas.APIKey
+	CreatedAt string `json:"createdAt,omitempty"`
+	LastUsed  string `json:"lastUsed,omitempty"`
+}
+
+type projectAPIKeysResponse struct {
+	Results    []projectAPIKey `json:"results,omitempty"`
+	TotalCount int             `json:"totalCount,omitempty"`
+}
+
 func resourceMongoDBAtlasProjectAPIKey() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMongoDBAtlasProjectAPIKeyCreate,
@@ -44,6 +60,14 @@ func resourceMongoDBAtlasProjectAPIKey() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"project_assignment": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -136,7 +160,7 @@ func resourceMongoDBAtlasProjectAPIKeyRead(ctx context.Context, d *schema.Resour
 	projectID := ids["project_id"]
 	apiKeyID := ids["api_key_id"]
 
-	projectAPIKeys, _, err := conn.ProjectAPIKeys.List(ctx, projectID, nil)
+	projectAPIKeys, err := listProjectAPIKeys(ctx, conn, projectID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error getting api key information: %s", err))
 	}
@@ -159,6 +183,14 @@ func resourceMongoDBAtlasProjectAPIKeyRead(ctx context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("error setting `public_key`: %s", err))
 		}
 
+		if err := d.Set("created_at", val.CreatedAt); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `created_at`: %s", err))
+		}
+
+		if err := d.Set("last_used", val.LastUsed); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `last_used`: %s", err))
+		}
+
 		if projectAssignments, err := newProjectAssignment(ctx, conn, apiKeyID); err == nil {
 			if err := d.Set("project_assignment", projectAssignments); err != nil {
 				return diag.Errorf(errorProjectSetting, `created`, projectID, err)
@@ -191,15 +223,21 @@ func resourceMongoDBAtlasProjectAPIKeyUpdate(ctx context.Context, d *schema.Reso
 	projectID := ids["project_id"]
 	apiKeyID := ids["api_key_id"]
 
-	updateRequest := new(matlas.AssignAPIKey)
-	if d.HasChange("role_names") {
-		updateRequest.Roles = expandStringList(d.Get("role_names").(*schema.Set).List())
-		if updateRequest.Roles != nil {
-			_, err := conn.ProjectAPIKeys.Assign(ctx, projectID, apiKeyID, updateRequest)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error updating API key: %s", err))
+	// the description and the roles in the key's own project are updated in place with a single PATCH, so
+	// changing them never recreates the key and its private key remains valid
+	if d.HasChange("description") || d.HasChange("project_assignment") {
+		newDescription := d.Get("description").(string)
+		updateRequest := &admin.UpdateAtlasProjectApiKey{
+			Desc: &newDescription,
+		}
+		for _, assignment := range ExpandProjectAssignmentSet(d.Get("project_assignment").(*schema.Set)) {
+			if assignment.ProjectID == projectID {
+				updateRequest.Roles = assignment.RoleNames
 			}
 		}
+		if _, _, err := connV2.ProgrammaticAPIKeysApi.UpdateApiKeyRoles(ctx, projectID, apiKeyID, updateRequest).Execute(); err != nil {
+			return diag.Errorf("error updating api key(%s): %s", apiKeyID, err)
+		}
 	}
 
 	if d.HasChange("project_assignment") {
@@ -229,9 +267,12 @@ func resourceMongoDBAtlasProjectAPIKeyUpdate(ctx context.Context, d *schema.Reso
 			}
 		}
 
-		// Updating the role names for the api_key
+		// Updating the role names for the api_key, the key's own project was already updated above
 		for _, apiKey := range changedAPIKeys {
 			projectID := apiKey.(map[string]interface{})["project_id"].(string)
+			if projectID == ids["project_id"] {
+				continue
+			}
 			roles := expandStringList(apiKey.(map[string]interface{})["role_names"].(*schema.Set).List())
 			_, err := conn.ProjectAPIKeys.Assign(ctx, projectID, apiKeyID, &matlas.AssignAPIKey{
 				Roles: roles,
@@ -242,15 +283,6 @@ func resourceMongoDBAtlasProjectAPIKeyUpdate(ctx context.Context, d *schema.Reso
 		}
 	}
 
-	if d.HasChange("description") {
-		newDescription := d.Get("description").(string)
-		if _, _, err := connV2.ProgrammaticAPIKeysApi.UpdateApiKeyRoles(ctx, projectID, apiKeyID, &admin.UpdateAtlasProjectApiKey{
-			Desc: &newDescription,
-		}).Execute(); err != nil {
-			return diag.Errorf("error updating description in api key(%s): %s", apiKeyID, err)
-		}
-	}
-
 	return resourceMongoDBAtlasProjectAPIKeyRead(ctx, d, meta)
 }
 
@@ -464,3 +496,18 @@ func getAPIProjectAssignments(ctx context.Context, conn *matlas.Client, apiKeyOr
 	}
 	return projectAssignments, nil
 }
+
+// listProjectAPIKeys lists the API keys of a project including their audit metadata.
+func listProjectAPIKeys(ctx context.Context, conn *matlas.Client, projectID string) ([]projectAPIKey, error) {
+	req, err := conn.NewRequest(ctx, http.MethodGet, fmt.Sprintf(projectAPIKeysPath, projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(projectAPIKeysResponse)
+	if _, err = conn.Do(ctx, req, root); err != nil {
+		return nil, err
+	}
+
+	return root.Results, nil
+}
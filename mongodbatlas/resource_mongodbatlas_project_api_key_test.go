@@ -83,6 +83,7 @@ func TestAccConfigRSProjectAPIKey_UpdateDescription(t *testing.T) {
 		description        = fmt.Sprintf("test-acc-project-api_key-%s", acctest.RandString(5))
 		updatedDescription = fmt.Sprintf("test-acc-project-api_key-updated-%s", acctest.RandString(5))
 		roleName           = "GROUP_OWNER"
+		apiKeyID           string
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,6 +97,10 @@ func TestAccConfigRSProjectAPIKey_UpdateDescription(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "description"),
 					resource.TestCheckResourceAttr(resourceName, "description", description),
+					resource.TestCheckResourceAttrWith(resourceName, "api_key_id", func(value string) error {
+						apiKeyID = value
+						return nil
+					}),
 				),
 			},
 			{
@@ -104,6 +109,12 @@ func TestAccConfigRSProjectAPIKey_UpdateDescription(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "description"),
 					resource.TestCheckResourceAttr(resourceName, "description", updatedDescription),
+					resource.TestCheckResourceAttrWith(resourceName, "api_key_id", func(value string) error {
+						if value != apiKeyID {
+							return fmt.Errorf("api key was recreated: expected %s, got %s", apiKeyID, value)
+						}
+						return nil
+					}),
 				),
 			},
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -55,6 +56,10 @@ func resourceMongoDBAtlasProjectInvitation() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"invitation_accepted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -72,13 +77,32 @@ func resourceMongoDBAtlasProjectInvitationRead(ctx context.Context, d *schema.Re
 		// case 404
 		// deleted in the backend case
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
+			// the invitation is also gone once the user accepts it, keep tracking the user's project roles in that case
+			roles, err := projectRolesOfUser(ctx, meta.(*MongoDBClient).AtlasV2, projectID, username)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error getting Project roles of user %s: %w", username, err))
+			}
+			if len(roles) == 0 {
+				return removeExternallyDeletedResource(d, meta, "mongodbatlas_project_invitation")
+			}
+
+			if err := d.Set("roles", roles); err != nil {
+				return diag.FromErr(fmt.Errorf("error getting `roles` for Project Invitation (%s): %w", d.Id(), err))
+			}
+			if err := d.Set("invitation_accepted", true); err != nil {
+				return diag.FromErr(fmt.Errorf("error getting `invitation_accepted` for Project Invitation (%s): %w", d.Id(), err))
+			}
+
 			return nil
 		}
 
 		return diag.FromErr(fmt.Errorf("error getting Project Invitation information: %w", err))
 	}
 
+	if err := d.Set("invitation_accepted", false); err != nil {
+		return diag.FromErr(fmt.Errorf("error getting `invitation_accepted` for Project Invitation (%s): %w", d.Id(), err))
+	}
+
 	if err := d.Set("username", projectInvitation.Username); err != nil {
 		return diag.FromErr(fmt.Errorf("error getting `username` for Project Invitation (%s): %w", d.Id(), err))
 	}
@@ -147,8 +171,20 @@ func resourceMongoDBAtlasProjectInvitationDelete(ctx context.Context, d *schema.
 	username := ids["username"]
 	invitationID := ids["invitation_id"]
 
-	_, err := conn.Projects.DeleteInvitation(ctx, projectID, invitationID)
+	if d.Get("invitation_accepted").(bool) {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Project invitation already accepted",
+			Detail: fmt.Sprintf("The Project invitation for user %s was already accepted, so the user stays in project %s with their current roles. "+
+				"Remove the user from the project in Atlas if they shouldn't keep access.", username, projectID),
+		}}
+	}
+
+	resp, err := conn.Projects.DeleteInvitation(ctx, projectID, invitationID)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
 		return diag.FromErr(fmt.Errorf("error deleting Project invitation for user %s: %w", username, err))
 	}
 
@@ -162,6 +198,15 @@ func resourceMongoDBAtlasProjectInvitationUpdate(ctx context.Context, d *schema.
 	username := ids["username"]
 	invitationID := ids["invitation_id"]
 
+	// the invitation doesn't exist anymore once accepted, so the roles the user has in the project are updated instead
+	if d.Get("invitation_accepted").(bool) {
+		if err := updateProjectRolesOfUser(ctx, meta.(*MongoDBClient).AtlasV2, projectID, username,
+			expandStringListFromSetSchema(d.Get("roles").(*schema.Set))); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Project roles of user %s: %w", username, err))
+		}
+		return resourceMongoDBAtlasProjectInvitationRead(ctx, d, meta)
+	}
+
 	invitationReq := &matlas.Invitation{
 		Roles: expandStringListFromSetSchema(d.Get("roles").(*schema.Set)),
 	}
@@ -211,6 +256,39 @@ func resourceMongoDBAtlasProjectInvitationImportState(ctx context.Context, d *sc
 	return nil, fmt.Errorf("could not import Project Invitation for %s", d.Id())
 }
 
+// projectRolesOfUser returns the roles the user has in the project, or nil if the user isn't a member.
+func projectRolesOfUser(ctx context.Context, connV2 *admin.APIClient, projectID, username string) ([]string, error) {
+	user, resp, err := connV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var roles []string
+	for _, role := range user.GetRoles() {
+		if role.GetGroupId() == projectID {
+			roles = append(roles, role.GetRoleName())
+		}
+	}
+
+	return roles, nil
+}
+
+// updateProjectRolesOfUser replaces the roles a user has in a project.
+func updateProjectRolesOfUser(ctx context.Context, connV2 *admin.APIClient, projectID, username string, roles []string) error {
+	user, _, err := connV2.MongoDBCloudUsersApi.GetUserByUsername(ctx, username).Execute()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = connV2.ProjectsApi.UpdateProjectRoles(ctx, projectID, user.GetId(), &admin.UpdateGroupRolesForUser{
+		GroupRoles: roles,
+	}).Execute()
+	return err
+}
+
 func splitProjectInvitationImportID(id string) (projectID, username string, err error) {
 	var re = regexp.MustCompile(`(?s)^([0-9a-fA-F]{24})-(.*)$`)
 	parts := re.FindStringSubmatch(id)
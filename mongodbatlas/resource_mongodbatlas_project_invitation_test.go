@@ -41,6 +41,7 @@ func TestAccProjectRSProjectInvitation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "roles.#"),
 					resource.TestCheckResourceAttr(resourceName, "username", name),
 					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "invitation_accepted", "false"),
 				),
 			},
 			{
@@ -13,9 +13,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+const (
+	searchIndexStatusFailed = "FAILED"
+	searchIndexStatusStale  = "STALE"
+)
+
 func resourceMongoDBAtlasSearchIndex() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMongoDBAtlasSearchIndexCreate,
@@ -58,6 +64,7 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 		"analyzers": {
 			Type:             schema.TypeString,
 			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: validateSearchAnalyzersDiff,
 		},
 		"collection_name": {
@@ -83,6 +90,7 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 		"mappings_fields": {
 			Type:             schema.TypeString,
 			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: validateSearchIndexMappingDiff,
 		},
 		"synonyms": {
@@ -110,6 +118,10 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"latest_definition": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 		"wait_for_index_build_completion": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -223,33 +235,14 @@ func resourceMongoDBAtlasSearchIndexUpdate(ctx context.Context, d *schema.Resour
 	}
 
 	searchIndex.IndexID = ""
-	dbSearchIndexRes, _, err := conn.Search.UpdateIndex(context.Background(), projectID, clusterName, indexID, searchIndex)
+	_, _, err = conn.Search.UpdateIndex(context.Background(), projectID, clusterName, indexID, searchIndex)
 	if err != nil {
 		return diag.Errorf("error updating search index (%s): %s", searchIndex.Name, err)
 	}
 
 	if d.Get("wait_for_index_build_completion").(bool) {
-		timeout := d.Timeout(schema.TimeoutCreate)
-		stateConf := &retry.StateChangeConf{
-			Pending:    []string{"IN_PROGRESS", "MIGRATING"},
-			Target:     []string{"STEADY"},
-			Refresh:    resourceSearchIndexRefreshFunc(ctx, clusterName, projectID, dbSearchIndexRes.IndexID, conn),
-			Timeout:    timeout,
-			MinTimeout: 1 * time.Minute,
-			Delay:      1 * time.Minute,
-		}
-
-		// Wait, catching any errors
-		_, err = stateConf.WaitForStateContext(ctx)
-		if err != nil {
-			d.SetId(encodeStateID(map[string]string{
-				"project_id":   projectID,
-				"cluster_name": clusterName,
-				"index_id":     dbSearchIndexRes.IndexID,
-			}))
-			resourceMongoDBAtlasSearchIndexDelete(ctx, d, meta)
-			d.SetId("")
-			return diag.FromErr(fmt.Errorf("error creating index in cluster (%s): %s", clusterName, err))
+		if err := waitSearchIndexBuild(ctx, conn, projectID, clusterName, indexID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error updating index in cluster (%s): %s", clusterName, err)
 		}
 	}
 
@@ -271,8 +264,7 @@ func resourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resource
 		reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
 
 		if reset {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_search_index")
 		}
 
 		return diag.Errorf("error getting search index information: %s", err)
@@ -331,6 +323,27 @@ func resourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	if err := d.Set("status", searchIndex.Status); err != nil {
+		return diag.Errorf("error setting `status` for search index (%s): %s", d.Id(), err)
+	}
+
+	latestDefinition, err := marshallSearchIndexDefinition(searchIndex)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("latest_definition", latestDefinition); err != nil {
+		return diag.Errorf("error setting `latest_definition` for search index (%s): %s", d.Id(), err)
+	}
+
+	if searchIndex.Status == searchIndexStatusStale {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("search index %s is stale", searchIndex.Name),
+			Detail:   "The index stopped replicating changes from the collection and queries may return outdated results. Check the index in Atlas and rebuild it if needed.",
+		}}
+	}
+
 	return nil
 }
 
@@ -358,6 +371,29 @@ func marshallSearchIndexAnalyzers(fields []map[string]interface{}) (string, erro
 	return string(mappingFieldJSON), err
 }
 
+// marshallSearchIndexDefinition returns the index definition currently applied in Atlas as a JSON document.
+func marshallSearchIndexDefinition(searchIndex *matlas.SearchIndex) (string, error) {
+	definition := map[string]interface{}{}
+	if searchIndex.Analyzer != "" {
+		definition["analyzer"] = searchIndex.Analyzer
+	}
+	if len(searchIndex.Analyzers) > 0 {
+		definition["analyzers"] = searchIndex.Analyzers
+	}
+	if searchIndex.Mappings != nil {
+		definition["mappings"] = searchIndex.Mappings
+	}
+	if searchIndex.SearchAnalyzer != "" {
+		definition["searchAnalyzer"] = searchIndex.SearchAnalyzer
+	}
+	if len(searchIndex.Synonyms) > 0 {
+		definition["synonyms"] = searchIndex.Synonyms
+	}
+
+	definitionJSON, err := json.Marshal(definition)
+	return string(definitionJSON), err
+}
+
 func marshallSearchIndexMappingsField(fields map[string]interface{}) (string, error) {
 	if len(fields) == 0 {
 		return "", nil
@@ -396,19 +432,7 @@ func resourceMongoDBAtlasSearchIndexCreate(ctx context.Context, d *schema.Resour
 		return diag.Errorf("error creating index: %s", err)
 	}
 	if d.Get("wait_for_index_build_completion").(bool) {
-		timeout := d.Timeout(schema.TimeoutCreate)
-		stateConf := &retry.StateChangeConf{
-			Pending:    []string{"IN_PROGRESS", "MIGRATING"},
-			Target:     []string{"STEADY"},
-			Refresh:    resourceSearchIndexRefreshFunc(ctx, clusterName, projectID, dbSearchIndexRes.IndexID, conn),
-			Timeout:    timeout,
-			MinTimeout: 1 * time.Minute,
-			Delay:      1 * time.Minute,
-		}
-
-		// Wait, catching any errors
-		_, err = stateConf.WaitForStateContext(ctx)
-		if err != nil {
+		if err := waitSearchIndexBuild(ctx, conn, projectID, clusterName, dbSearchIndexRes.IndexID, d.Timeout(schema.TimeoutCreate)); err != nil {
 			d.SetId(encodeStateID(map[string]string{
 				"project_id":   projectID,
 				"cluster_name": clusterName,
@@ -449,23 +473,28 @@ func expandSearchIndexSynonyms(d *schema.ResourceData) []map[string]interface{}
 }
 
 func validateSearchIndexMappingDiff(k, old, newStr string, d *schema.ResourceData) bool {
-	var j, j2 interface{}
-
-	if old == "" {
-		old = "{}"
-	}
+	return isSearchIndexJSONEqual(old, newStr)
+}
 
-	if newStr == "" {
-		newStr = "{}"
-	}
+func validateSearchAnalyzersDiff(k, old, newStr string, d *schema.ResourceData) bool {
+	return isSearchIndexJSONEqual(old, newStr)
+}
 
-	if err := json.Unmarshal([]byte(old), &j); err != nil {
-		log.Printf("[ERROR] cannot unmarshal old search index mapping json %v", err)
+// isSearchIndexJSONEqual compares two JSON documents semantically, so formatting, key order and
+// empty documents (empty string, `{}`, `[]` or `null`) don't produce a diff.
+func isSearchIndexJSONEqual(old, newStr string) bool {
+	j, err := normalizeSearchIndexJSON(old)
+	if err != nil {
+		log.Printf("[ERROR] cannot unmarshal old search index json %v", err)
+		return false
 	}
-	if err := json.Unmarshal([]byte(newStr), &j2); err != nil {
-		log.Printf("[ERROR] cannot unmarshal new search index mapping json %v", err)
+	j2, err := normalizeSearchIndexJSON(newStr)
+	if err != nil {
+		log.Printf("[ERROR] cannot unmarshal new search index json %v", err)
+		return false
 	}
-	if diff := deep.Equal(&j, &j2); diff != nil {
+
+	if diff := deep.Equal(j, j2); diff != nil {
 		log.Printf("[DEBUG] deep equal not passed: %v", diff)
 		return false
 	}
@@ -473,29 +502,28 @@ func validateSearchIndexMappingDiff(k, old, newStr string, d *schema.ResourceDat
 	return true
 }
 
-func validateSearchAnalyzersDiff(k, old, newStr string, d *schema.ResourceData) bool {
-	var j, j2 interface{}
-
-	if old == "" {
-		old = "{}"
+func normalizeSearchIndexJSON(str string) (interface{}, error) {
+	if strings.TrimSpace(str) == "" {
+		return nil, nil
 	}
 
-	if newStr == "" {
-		newStr = "{}"
+	var j interface{}
+	if err := json.Unmarshal([]byte(str), &j); err != nil {
+		return nil, err
 	}
 
-	if err := json.Unmarshal([]byte(old), &j); err != nil {
-		log.Printf("[ERROR] cannot unmarshal old search index analyzer json %v", err)
-	}
-	if err := json.Unmarshal([]byte(newStr), &j2); err != nil {
-		log.Printf("[ERROR] cannot unmarshal new search index analyzer json %v", err)
-	}
-	if diff := deep.Equal(&j, &j2); diff != nil {
-		log.Printf("[DEBUG] deep equal not passed: %v", diff)
-		return false
+	switch v := j.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, nil
+		}
 	}
 
-	return true
+	return j, nil
 }
 
 func unmarshalSearchIndexMappingFields(mappingString string) map[string]interface{} {
@@ -528,20 +556,30 @@ func unmarshalSearchIndexAnalyzersFields(mappingString string) []map[string]inte
 	return fields
 }
 
+func waitSearchIndexBuild(ctx context.Context, conn *matlas.Client, projectID, clusterName, indexID string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"PENDING", "BUILDING", "IN_PROGRESS", "MIGRATING"},
+		Target:     []string{"STEADY", "READY"},
+		Refresh:    resourceSearchIndexRefreshFunc(ctx, clusterName, projectID, indexID, conn),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func resourceSearchIndexRefreshFunc(ctx context.Context, clusterName, projectID, indexID string, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		searchIndex, resp, err := client.Search.GetIndex(ctx, projectID, clusterName, indexID)
 		if err != nil {
-			return nil, "ERROR", err
-		}
-
-		if err != nil && searchIndex == nil && resp == nil {
-			return nil, "", err
-		} else if err != nil {
-			if resp.StatusCode == 404 {
+			if resp != nil && resp.StatusCode == 404 {
 				return "", "DELETED", nil
 			}
-			if resp.StatusCode == 503 {
+			if resp != nil && resp.StatusCode == 503 {
 				return "", "PENDING", nil
 			}
 			return nil, "", err
@@ -551,6 +589,10 @@ func resourceSearchIndexRefreshFunc(ctx context.Context, clusterName, projectID,
 			log.Printf("[DEBUG] status for Search Index : %s: %s", clusterName, searchIndex.Status)
 		}
 
+		if searchIndex.Status == searchIndexStatusFailed {
+			return nil, searchIndex.Status, fmt.Errorf("search index %s build failed", searchIndex.Name)
+		}
+
 		return searchIndex, searchIndex.Status, nil
 	}
 }
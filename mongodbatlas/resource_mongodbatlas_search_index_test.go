@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -34,6 +35,8 @@ func TestAccClusterRSSearchIndex_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "project_id", projectID),
 					resource.TestCheckResourceAttr(resourceName, "cluster_name", clusterName),
+					resource.TestMatchResourceAttr(resourceName, "status", regexp.MustCompile("^(STEADY|READY)$")),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_definition"),
 					resource.TestCheckResourceAttrSet(datasourceName, "name"),
 					resource.TestCheckResourceAttrSet(datasourceName, "project_id"),
 					resource.TestCheckResourceAttrSet(datasourceName, "name"),
@@ -220,6 +223,8 @@ func testAccMongoDBAtlasSearchIndexConfig(projectID, clusterName string) string
 			mappings_dynamic = "true"
 			name             = "name_test"
 			search_analyzer  = "lucene.standard"
+
+			wait_for_index_build_completion = true
 		}
 
 		data "mongodbatlas_search_indexes" "data_index" {
@@ -431,3 +436,28 @@ func testAccCheckMongoDBAtlasSearchIndexImportStateIDFunc(resourceName string) r
 		return fmt.Sprintf("%s--%s--%s", ids["project_id"], ids["cluster_name"], ids["index_id"]), nil
 	}
 }
+
+func TestResourceMongoDBAtlasSearchIndex_isSearchIndexJSONEqual(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      string
+		newStr   string
+		expected bool
+	}{
+		{name: "reformatted document", old: `{"address":{"type":"document"}}`, newStr: "{\n  \"address\": {\n    \"type\": \"document\"\n  }\n}", expected: true},
+		{name: "reordered keys", old: `{"a":1,"b":2}`, newStr: `{"b":2,"a":1}`, expected: true},
+		{name: "empty object and unset", old: "", newStr: "{}", expected: true},
+		{name: "empty array and unset", old: "[]", newStr: "", expected: true},
+		{name: "different values", old: `{"a":1}`, newStr: `{"a":2}`, expected: false},
+		{name: "reordered array", old: `[{"name":"a"},{"name":"b"}]`, newStr: `[{"name":"b"},{"name":"a"}]`, expected: false},
+		{name: "invalid json", old: `{"a":1}`, newStr: `{"a":`, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isSearchIndexJSONEqual(tc.old, tc.newStr); got != tc.expected {
+				t.Errorf("isSearchIndexJSONEqual(%q, %q) = %t, expected %t", tc.old, tc.newStr, got, tc.expected)
+			}
+		})
+	}
+}
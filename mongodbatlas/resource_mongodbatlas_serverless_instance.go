@@ -200,6 +200,10 @@ func resourceMongoDBAtlasServerlessInstanceDelete(ctx context.Context, d *schema
 	projectID := ids["project_id"]
 	serverlessName := ids["name"]
 
+	if d.Get("termination_protection_enabled").(bool) {
+		return diag.Errorf(errorTerminationProtectionEnabled, "MongoDB Serverless Instance", serverlessName)
+	}
+
 	_, err := conn.ServerlessInstances.Delete(ctx, projectID, serverlessName)
 
 	if err != nil {
@@ -240,8 +244,7 @@ func resourceMongoDBAtlasServerlessInstanceRead(ctx context.Context, d *schema.R
 		reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
 
 		if reset {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_serverless_instance")
 		}
 
 		return diag.Errorf("error getting serverless instance information: %s", err)
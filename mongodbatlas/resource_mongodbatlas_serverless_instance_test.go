@@ -2,16 +2,27 @@ package mongodbatlas
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
+func init() {
+	resource.AddTestSweepers("mongodbatlas_serverless_instance", &resource.Sweeper{
+		Name: "mongodbatlas_serverless_instance",
+		F:    testSweepServerlessInstances,
+	})
+}
+
 func TestAccServerlessInstance_basic(t *testing.T) {
 	var (
 		serverlessInstance      matlas.Cluster
@@ -278,3 +289,56 @@ func testAccMongoDBAtlasServerlessInstanceConfigWithTags(orgID, projectName, nam
 	}
 	return fmt.Sprintf(serverlessConfig, orgID, projectName, name, tagsConf)
 }
+
+// testSweepServerlessInstances deletes the serverless instances of the projects created by the acceptance tests, and
+// the instances the tests created in the shared project. It waits for the deletions, as the projects can't be deleted
+// before.
+func testSweepServerlessInstances(_ string) error {
+	client, orgID, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	conn := client.Atlas
+	projectIDs, err := sweepableProjectIDs(ctx, conn, orgID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for projectID, sweepAll := range projectIDs {
+		instances, _, err := conn.ServerlessInstances.List(ctx, projectID, &matlas.ListOptions{ItemsPerPage: sweeperItemsPerPage})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error listing the serverless instances of project %s: %s", projectID, err))
+			continue
+		}
+
+		for _, instance := range instances.Results {
+			if !sweepAll && !isSweepable(instance.Name) {
+				continue
+			}
+
+			log.Printf("[INFO] sweeping serverless instance %s of project %s", instance.Name, projectID)
+			if instance.StateName != "DELETING" {
+				if _, err := conn.ServerlessInstances.Delete(ctx, projectID, instance.Name); err != nil {
+					errs = append(errs, fmt.Errorf("error deleting serverless instance %s of project %s: %s", instance.Name, projectID, err))
+					continue
+				}
+			}
+
+			stateConf := &retry.StateChangeConf{
+				Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
+				Target:     []string{"DELETED"},
+				Refresh:    resourceServerlessInstanceRefreshFunc(ctx, instance.Name, projectID, conn),
+				Timeout:    1 * time.Hour,
+				MinTimeout: 30 * time.Second,
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("error waiting for serverless instance %s of project %s to be deleted: %s", instance.Name, projectID, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,339 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	streamsProcessorsPath          = "api/atlas/v2/groups/%s/streams/%s/processor"
+	streamsProcessorPath           = "api/atlas/v2/groups/%s/streams/%s/processor/%s"
+	streamsProcessorStateInit      = "INIT"
+	streamsProcessorStateCreating  = "CREATING"
+	streamsProcessorStateCreated   = "CREATED"
+	streamsProcessorStateStarted   = "STARTED"
+	streamsProcessorStateStopped   = "STOPPED"
+	streamsProcessorStateFailed    = "FAILED"
+	errorStreamsProcessorCreate    = "error creating MongoDB Atlas stream processor (%s): %s"
+	errorStreamsProcessorRead      = "error getting MongoDB Atlas stream processor (%s): %s"
+	errorStreamsProcessorDelete    = "error deleting MongoDB Atlas stream processor (%s): %s"
+	errorStreamsProcessorSetting   = "error setting `%s` for MongoDB Atlas stream processor (%s): %s"
+	errorStreamsProcessorState     = "error changing the state of MongoDB Atlas stream processor (%s) to %s: %s"
+	errorStreamsProcessorPipeline  = "error decoding the pipeline of MongoDB Atlas stream processor (%s): %s"
+	errorStreamsProcessorImportFmt = "import format error: to import a stream processor, use the format {project_id}--{instance_name}--{processor_name}"
+	// the stream processor endpoints were added to the Atlas Administration API in the 2024-05-30 version
+	streamsProcessorAPIVersion = atlasAPIV2Version20240530
+)
+
+type streamsProcessor struct {
+	ID       string        `json:"_id,omitempty"`
+	Name     string        `json:"name,omitempty"`
+	State    string        `json:"state,omitempty"`
+	Pipeline []interface{} `json:"pipeline,omitempty"`
+}
+
+func resourceMongoDBAtlasStreamsProcessor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasStreamsProcessorCreate,
+		ReadContext:   resourceMongoDBAtlasStreamsProcessorRead,
+		UpdateContext: resourceMongoDBAtlasStreamsProcessorUpdate,
+		DeleteContext: resourceMongoDBAtlasStreamsProcessorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMongoDBAtlasStreamsProcessorImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"processor_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pipeline": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStreamsProcessorPipeline,
+				DiffSuppressFunc: validateStreamsProcessorPipelineDiff,
+			},
+			"desired_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      streamsProcessorStateStarted,
+				ValidateFunc: validation.StringInSlice([]string{streamsProcessorStateStarted, streamsProcessorStateStopped}, false),
+			},
+			"processor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func resourceMongoDBAtlasStreamsProcessorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	projectID := d.Get("project_id").(string)
+	instanceName := d.Get("instance_name").(string)
+	processorName := d.Get("processor_name").(string)
+
+	var pipeline []interface{}
+	if err := json.Unmarshal([]byte(d.Get("pipeline").(string)), &pipeline); err != nil {
+		return diag.Errorf(errorStreamsProcessorPipeline, processorName, err)
+	}
+
+	processor := &streamsProcessor{
+		Name:     processorName,
+		Pipeline: pipeline,
+	}
+	path := fmt.Sprintf(streamsProcessorsPath, projectID, instanceName)
+	if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodPost, path, streamsProcessorAPIVersion, processor, nil); err != nil {
+		return diag.Errorf(errorStreamsProcessorCreate, processorName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":     projectID,
+		"instance_name":  instanceName,
+		"processor_name": processorName,
+	}))
+
+	// The processor is created stopped, it only needs to be started when that's the desired state.
+	if d.Get("desired_state").(string) == streamsProcessorStateStarted {
+		if err := changeStreamsProcessorState(ctx, conn, projectID, instanceName, processorName, streamsProcessorStateStarted, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf(errorStreamsProcessorState, processorName, streamsProcessorStateStarted, err)
+		}
+	}
+
+	return resourceMongoDBAtlasStreamsProcessorRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasStreamsProcessorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	instanceName := ids["instance_name"]
+	processorName := ids["processor_name"]
+
+	processor, resp, err := getStreamsProcessor(ctx, conn, projectID, instanceName, processorName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_streams_processor")
+		}
+		return diag.Errorf(errorStreamsProcessorRead, processorName, err)
+	}
+
+	pipeline, err := json.Marshal(processor.Pipeline)
+	if err != nil {
+		return diag.Errorf(errorStreamsProcessorPipeline, processorName, err)
+	}
+
+	values := map[string]interface{}{
+		"project_id":     projectID,
+		"instance_name":  instanceName,
+		"processor_name": processor.Name,
+		"pipeline":       string(pipeline),
+		"processor_id":   processor.ID,
+		"state":          processor.State,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(errorStreamsProcessorSetting, key, processorName, err)
+		}
+	}
+
+	// A processor that was stopped or failed outside of Terraform shows up as a diff on desired_state.
+	if processor.State == streamsProcessorStateStarted || processor.State == streamsProcessorStateStopped {
+		if err = d.Set("desired_state", processor.State); err != nil {
+			return diag.Errorf(errorStreamsProcessorSetting, "desired_state", processorName, err)
+		}
+	} else if err = d.Set("desired_state", streamsProcessorStateStopped); err != nil {
+		return diag.Errorf(errorStreamsProcessorSetting, "desired_state", processorName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasStreamsProcessorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	instanceName := ids["instance_name"]
+	processorName := ids["processor_name"]
+
+	if d.HasChange("desired_state") {
+		desiredState := d.Get("desired_state").(string)
+		if err := changeStreamsProcessorState(ctx, conn, projectID, instanceName, processorName, desiredState, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf(errorStreamsProcessorState, processorName, desiredState, err)
+		}
+	}
+
+	return resourceMongoDBAtlasStreamsProcessorRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasStreamsProcessorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	instanceName := ids["instance_name"]
+	processorName := ids["processor_name"]
+
+	path := fmt.Sprintf(streamsProcessorPath, projectID, instanceName, processorName)
+	if resp, err := doAtlasAPIV2Request(ctx, conn, http.MethodDelete, path, streamsProcessorAPIVersion, nil, nil); err != nil {
+		// the processor, or the instance it ran in, was already deleted
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf(errorStreamsProcessorDelete, processorName, err)
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasStreamsProcessorImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*MongoDBClient).Atlas
+
+	parts := strings.SplitN(d.Id(), "--", 3)
+	if len(parts) != 3 {
+		return nil, errors.New(errorStreamsProcessorImportFmt)
+	}
+
+	projectID := parts[0]
+	instanceName := parts[1]
+	processorName := parts[2]
+
+	if _, _, err := getStreamsProcessor(ctx, conn, projectID, instanceName, processorName); err != nil {
+		return nil, fmt.Errorf("couldn't import stream processor (%s) in project (%s) and instance (%s), error: %s", processorName, projectID, instanceName, err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":     projectID,
+		"instance_name":  instanceName,
+		"processor_name": processorName,
+	}))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getStreamsProcessor(ctx context.Context, conn *matlas.Client, projectID, instanceName, processorName string) (*streamsProcessor, *matlas.Response, error) {
+	path := fmt.Sprintf(streamsProcessorPath, projectID, instanceName, processorName)
+	root := new(streamsProcessor)
+	resp, err := doAtlasAPIV2Request(ctx, conn, http.MethodGet, path, streamsProcessorAPIVersion, nil, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// changeStreamsProcessorState starts or stops the processor and waits until Atlas reports the new state.
+func changeStreamsProcessorState(ctx context.Context, conn *matlas.Client, projectID, instanceName, processorName, desiredState string, timeout time.Duration) error {
+	action := ":start"
+	if desiredState == streamsProcessorStateStopped {
+		action = ":stop"
+	}
+
+	path := fmt.Sprintf(streamsProcessorPath, projectID, instanceName, processorName) + action
+	if _, err := doAtlasAPIV2Request(ctx, conn, http.MethodPost, path, streamsProcessorAPIVersion, nil, nil); err != nil {
+		return err
+	}
+
+	// only the states a processor goes through before reaching the desired one are pending, so a processor that
+	// stays in the opposite state fails the wait instead of blocking until the timeout
+	pending := []string{streamsProcessorStateInit, streamsProcessorStateCreating}
+	if desiredState == streamsProcessorStateStarted {
+		pending = append(pending, streamsProcessorStateCreated)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{desiredState},
+		Refresh:    resourceStreamsProcessorRefreshFunc(ctx, conn, projectID, instanceName, processorName),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceStreamsProcessorRefreshFunc(ctx context.Context, conn *matlas.Client, projectID, instanceName, processorName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		processor, _, err := getStreamsProcessor(ctx, conn, projectID, instanceName, processorName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if processor.State == streamsProcessorStateFailed {
+			return nil, processor.State, fmt.Errorf("the stream processor reported the %s state", streamsProcessorStateFailed)
+		}
+
+		log.Printf("[DEBUG] status: %s", processor.State)
+		return processor, processor.State, nil
+	}
+}
+
+func validateStreamsProcessorPipeline(v interface{}, p cty.Path) diag.Diagnostics {
+	var pipeline []interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &pipeline); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid stream processor pipeline",
+			Detail:        fmt.Sprintf("expected a JSON array of aggregation stages: %s", err),
+			AttributePath: p,
+		}}
+	}
+	return nil
+}
+
+// validateStreamsProcessorPipelineDiff compares the pipelines semantically, so formatting and key order
+// don't produce a diff.
+func validateStreamsProcessorPipelineDiff(k, old, newStr string, d *schema.ResourceData) bool {
+	var j, j2 interface{}
+	if err := json.Unmarshal([]byte(old), &j); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(newStr), &j2); err != nil {
+		return false
+	}
+
+	if diff := deep.Equal(j, j2); diff != nil {
+		log.Printf("[DEBUG] deep equal not passed: %v", diff)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,116 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConfigRSStreamsProcessor_basic(t *testing.T) {
+	SkipTestForCI(t)
+	var (
+		resourceName  = "mongodbatlas_streams_processor.test"
+		projectID     = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		instanceName  = os.Getenv("MONGODB_ATLAS_STREAM_INSTANCE_NAME")
+		processorName = acctest.RandomWithPrefix("test-acc")
+		// The stream instance must have the sample_stream_solar connection.
+		pipeline = `[{"$source": {"connectionName": "sample_stream_solar"}}, {"$emit": {"connectionName": "__testLog"}}]`
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasStreamsProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasStreamsProcessorConfig(projectID, instanceName, processorName, pipeline, "STOPPED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamsProcessorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "processor_name", processorName),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "STOPPED"),
+					resource.TestCheckResourceAttrSet(resourceName, "processor_id"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasStreamsProcessorConfig(projectID, instanceName, processorName, pipeline, "STARTED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamsProcessorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "STARTED"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STARTED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasStreamsProcessorImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasStreamsProcessorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getStreamsProcessor(context.Background(), conn, ids["project_id"], ids["instance_name"], ids["processor_name"]); err != nil {
+			return fmt.Errorf("stream processor (%s) does not exist", ids["processor_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasStreamsProcessorDestroy(state *terraform.State) error {
+	conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "mongodbatlas_streams_processor" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if _, _, err := getStreamsProcessor(context.Background(), conn, ids["project_id"], ids["instance_name"], ids["processor_name"]); err == nil {
+			return fmt.Errorf("stream processor (%s) still exists", ids["processor_name"])
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMongoDBAtlasStreamsProcessorImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s--%s--%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["instance_name"], rs.Primary.Attributes["processor_name"]), nil
+	}
+}
+
+func testAccMongoDBAtlasStreamsProcessorConfig(projectID, instanceName, processorName, pipeline, desiredState string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_streams_processor" "test" {
+			project_id     = %[1]q
+			instance_name  = %[2]q
+			processor_name = %[3]q
+			pipeline       = %[4]q
+			desired_state  = %[5]q
+		}
+	`, projectID, instanceName, processorName, pipeline, desiredState)
+}
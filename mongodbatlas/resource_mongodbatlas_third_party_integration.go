@@ -26,6 +26,13 @@ var deprecatedIntegrationTypes = []string{
 	"FLOWDOCK",
 }
 
+// regionsPerType lists the regions, or sites, supported by the integrations that must be configured with one.
+var regionsPerType = map[string][]string{
+	"DATADOG":    {"US", "EU", "US3", "US5", "AP1", "US1_FED"},
+	"OPS_GENIE":  {"US", "EU"},
+	"PAGER_DUTY": {"US", "EU"},
+}
+
 var requiredPerType = map[string][]string{
 	"PAGER_DUTY":      {"service_key"},
 	"DATADOG":         {"api_key", "region"},
@@ -42,6 +49,7 @@ func resourceMongoDBAtlasThirdPartyIntegration() *schema.Resource {
 		ReadContext:   resourceMongoDBAtlasThirdPartyIntegrationRead,
 		UpdateContext: resourceMongoDBAtlasThirdPartyIntegrationUpdate,
 		DeleteContext: resourceMongoDBAtlasThirdPartyIntegrationDelete,
+		CustomizeDiff: resourceThirdPartyIntegrationCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasThirdPartyIntegrationImportState,
 		},
@@ -169,8 +177,7 @@ func resourceMongoDBAtlasThirdPartyIntegrationRead(ctx context.Context, d *schem
 	integration, resp, err := conn.Integrations.Get(context.Background(), projectID, integrationType)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			return nil
+			return removeExternallyDeletedResource(d, meta, "mongodbatlas_third_party_integration")
 		}
 
 		return diag.FromErr(fmt.Errorf("error getting third party integration resource info %s %w", integrationType, err))
@@ -280,6 +287,23 @@ func splitIntegrationTypeID(id string) (projectID, integrationType string, err e
 	return
 }
 
+// resourceThirdPartyIntegrationCustomizeDiff checks at plan time that the region is supported by the integration
+// type, as the valid regions depend on the type.
+func resourceThirdPartyIntegrationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("region") {
+		return nil
+	}
+
+	integrationType := d.Get("type").(string)
+	region := d.Get("region").(string)
+	regions, ok := regionsPerType[integrationType]
+	if !ok || region == "" || isElementExist(regions, region) {
+		return nil
+	}
+
+	return fmt.Errorf("region %q is not supported by the %s integration, possible values are: %q", region, integrationType, regions)
+}
+
 func validateIntegrationType() schema.SchemaValidateDiagFunc {
 	return func(v any, p cty.Path) diag.Diagnostics {
 		value := v.(string)
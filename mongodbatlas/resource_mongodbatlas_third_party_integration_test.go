@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -153,6 +154,36 @@ func TestAccConfigRSThirdPartyIntegration_updateBasic(t *testing.T) {
 	)
 }
 
+func TestAccConfigRSThirdPartyIntegration_invalidRegion(t *testing.T) {
+	var (
+		projectID         = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+		config            = testAccCreateThirdPartyIntegrationConfig()
+		testExecutionName = "test_3rd_party_" + config.AccountID
+	)
+
+	config.Type = "DATADOG"
+	config.Region = "EU2"
+
+	seedConfig := thirdPartyConfig{
+		Name:        testExecutionName,
+		ProjectID:   projectID,
+		Integration: *config,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDBAtlasThirdPartyIntegrationResourceConfig(&seedConfig),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`region "EU2" is not supported by the DATADOG integration`),
+			},
+		},
+	},
+	)
+}
+
 func testAccCheckMongoDBAtlasThirdPartyIntegrationDestroy(s *terraform.State) error {
 	conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
 	for _, rs := range s.RootModule().Resources {
@@ -150,8 +150,7 @@ func resourceMongoDBAtlasX509AuthDBUserRead(ctx context.Context, d *schema.Resou
 			// new resource missing
 			reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
 			if reset {
-				d.SetId("")
-				return nil
+				return removeExternallyDeletedResource(d, meta, "mongodbatlas_x509_authentication_database_user")
 			}
 			return diag.FromErr(fmt.Errorf(errorX509AuthDBUsersRead, username, projectID, err))
 		}
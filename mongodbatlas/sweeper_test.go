@@ -0,0 +1,86 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	// sweeperNamePrefix is the prefix of the names generated by the acceptance tests, e.g. with
+	// acctest.RandomWithPrefix("test-acc"). The sweepers only delete what starts with it.
+	sweeperNamePrefix       = "test-acc"
+	sweeperItemsPerPage     = 500
+	sweeperSharedProjectEnv = "MONGODB_ATLAS_PROJECT_ID"
+)
+
+// sweeperClient returns a client configured from the acceptance tests environment variables. Atlas resources
+// aren't regional, so the region passed to the sweepers, e.g. `go test ./mongodbatlas -sweep=all`, is ignored.
+func sweeperClient() (*MongoDBClient, string, error) {
+	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
+	if os.Getenv("MONGODB_ATLAS_PUBLIC_KEY") == "" || os.Getenv("MONGODB_ATLAS_PRIVATE_KEY") == "" || orgID == "" {
+		return nil, "", errors.New("`MONGODB_ATLAS_PUBLIC_KEY`, `MONGODB_ATLAS_PRIVATE_KEY` and `MONGODB_ATLAS_ORG_ID` must be set to run the sweepers")
+	}
+
+	config := Config{
+		PublicKey:    os.Getenv("MONGODB_ATLAS_PUBLIC_KEY"),
+		PrivateKey:   os.Getenv("MONGODB_ATLAS_PRIVATE_KEY"),
+		BaseURL:      os.Getenv("MONGODB_ATLAS_BASE_URL"),
+		RealmBaseURL: os.Getenv("MONGODB_REALM_BASE_URL"),
+	}
+	client, err := config.NewClient(context.Background())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return client.(*MongoDBClient), orgID, nil
+}
+
+func isSweepable(name string) bool {
+	return strings.HasPrefix(name, sweeperNamePrefix)
+}
+
+// sweepableProjects returns the projects of the organization created by the acceptance tests.
+func sweepableProjects(ctx context.Context, conn *matlas.Client, orgID string) ([]*matlas.Project, error) {
+	var projects []*matlas.Project
+	for page := 1; ; page++ {
+		root, _, err := conn.Projects.GetAllProjects(ctx, &matlas.ListOptions{PageNum: page, ItemsPerPage: sweeperItemsPerPage})
+		if err != nil {
+			return nil, fmt.Errorf("error listing projects: %s", err)
+		}
+
+		for _, project := range root.Results {
+			if project.OrgID == orgID && isSweepable(project.Name) {
+				projects = append(projects, project)
+			}
+		}
+
+		if len(root.Results) < sweeperItemsPerPage {
+			return projects, nil
+		}
+	}
+}
+
+// sweepableProjectIDs returns the IDs of the projects the sweepers look into, along with whether everything in them
+// can be deleted: the projects created by the acceptance tests, where anything can be deleted, and the project shared
+// by the tests, where only what the tests named can be.
+func sweepableProjectIDs(ctx context.Context, conn *matlas.Client, orgID string) (map[string]bool, error) {
+	projects, err := sweepableProjects(ctx, conn, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIDs := make(map[string]bool, len(projects)+1)
+	for _, project := range projects {
+		projectIDs[project.ID] = true
+	}
+	if sharedProjectID := os.Getenv(sweeperSharedProjectEnv); sharedProjectID != "" {
+		projectIDs[sharedProjectID] = false
+	}
+
+	return projectIDs, nil
+}